@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 const (
@@ -23,18 +24,40 @@ func main() {
 	log := setupLogger(cfg.Env)
 
 	ctx := context.Background()
-	application := app.New(log, cfg.GRPC.Port, cfg.DBConnString, ctx)
+	application := app.New(log, cfg.GRPC, cfg.HTTP, cfg.Metrics, cfg.DBConnString, ctx)
 
 	go func () {
 		application.GRPCServer.MustRun()
 	}()
 
+	if application.HTTPGateway != nil {
+		go func () {
+			application.HTTPGateway.MustRun()
+		}()
+	}
+
+	if application.MetricsServer != nil {
+		go func () {
+			application.MetricsServer.MustRun()
+		}()
+	}
+
 	log.Info("application start!")
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
 	<- stop
+	if application.HTTPGateway != nil {
+		shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		application.HTTPGateway.Stop(shutdownCtx)
+		cancel()
+	}
+	if application.MetricsServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		application.MetricsServer.Stop(shutdownCtx)
+		cancel()
+	}
 	application.GRPCServer.Stop()
 	log.Info("Gracefully stopped")	
 }
@@ -0,0 +1,151 @@
+// Command dbcpctl is an admin client for the dbcp gRPC API.
+//
+//	dbcpctl [flags] <service> <method> [method flags]
+//
+// Every service of the API is a command and every RPC a subcommand; the
+// method flags follow the request fields. Run dbcpctl <service> to list
+// its methods and dbcpctl <service> <method> -h for their flags.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const defaultAddr = "localhost:44044"
+
+// errUsage reports bad command line arguments. The flag package has
+// already printed the problem together with the usage by then.
+var errUsage = errors.New("usage")
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		fmt.Fprintln(os.Stderr, "dbcpctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("dbcpctl", flag.ContinueOnError)
+	addr := fs.String("addr", envOr("DBCP_ADDR", defaultAddr), "server address, $DBCP_ADDR")
+	output := fs.String("o", outputTable, "output format: table, json or yaml")
+	timeout := fs.Duration("timeout", 30*time.Second, "call timeout")
+	actorName := fs.String("actor", os.Getenv("DBCP_ACTOR"), "name claimed in the audit log when auth is off, $DBCP_ACTOR")
+	apiKey := fs.String("api-key", os.Getenv("DBCP_API_KEY"), "API key, $DBCP_API_KEY")
+	token := fs.String("token", os.Getenv("DBCP_TOKEN"), "JWT bearer token, $DBCP_TOKEN")
+	var tlsOpts tlsFlags
+	tlsOpts.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: dbcpctl [flags] <service> <method> [method flags]")
+		fmt.Fprintln(fs.Output(), "\nservices:")
+		for _, name := range serviceNames() {
+			fmt.Fprintln(fs.Output(), "  "+name)
+		}
+		fmt.Fprintln(fs.Output(), "\nflags:")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	sd, ok := services()[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown service %q, one of: %s", fs.Arg(0), strings.Join(serviceNames(), ", "))
+	}
+	if fs.NArg() == 1 {
+		printMethods(fs.Arg(0), sd)
+		return nil
+	}
+
+	md, err := findMethod(sd, fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	req, err := newMessage(md.Input())
+	if err != nil {
+		return err
+	}
+	resp, err := newMessage(md.Output())
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("dbcpctl %s %s", fs.Arg(0), fs.Arg(1))
+	if err := parseRequest(name, req, fs.Args()[2:]); err != nil {
+		return err
+	}
+
+	creds, err := tlsOpts.credentials()
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if *actorName != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-actor", *actorName)
+	}
+	if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", *apiKey)
+	}
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+
+	if err := conn.Invoke(ctx, fullMethod(md), req.Interface(), resp.Interface()); err != nil {
+		st := status.Convert(err)
+		return fmt.Errorf("%s: %s", st.Code(), st.Message())
+	}
+
+	return printResponse(os.Stdout, *output, resp)
+}
+
+func newMessage(md protoreflect.MessageDescriptor) (protoreflect.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New(), nil
+}
+
+func printMethods(service string, sd protoreflect.ServiceDescriptor) {
+	fmt.Printf("usage: dbcpctl %s <method> [method flags]\n\nmethods:\n", service)
+	methods := sd.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		fmt.Println("  " + commandName(string(methods.Get(i).Name())))
+	}
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
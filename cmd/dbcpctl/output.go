@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+func printResponse(w io.Writer, format string, resp protoreflect.Message) error {
+	switch format {
+	case outputJSON:
+		b, err := protojson.MarshalOptions{
+			Multiline:       true,
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		}.Marshal(resp.Interface())
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	case outputYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(yamlMessage(resp)); err != nil {
+			return err
+		}
+		return enc.Close()
+	case outputTable:
+		return printTable(w, resp)
+	}
+
+	return fmt.Errorf("unknown output format %q", format)
+}
+
+// printTable prints the first list in the response one row per item, or
+// the response itself one row per field. The remaining fields, such as
+// the next page token, follow the table.
+func printTable(w io.Writer, resp protoreflect.Message) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fields := populated(resp)
+	if len(fields) == 0 {
+		fmt.Fprintln(tw, "OK")
+		return tw.Flush()
+	}
+
+	if list := firstMessageList(resp); list != nil {
+		item := list.Message()
+		columns := item.Fields()
+		header := make([]string, 0, columns.Len())
+		for i := 0; i < columns.Len(); i++ {
+			header = append(header, strings.ToUpper(string(columns.Get(i).Name())))
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+		items := resp.Get(list).List()
+		for i := 0; i < items.Len(); i++ {
+			m := items.Get(i).Message()
+			row := make([]string, 0, columns.Len())
+			for j := 0; j < columns.Len(); j++ {
+				row = append(row, cell(m, columns.Get(j)))
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		for _, fd := range fields {
+			if fd != list {
+				fmt.Fprintf(w, "%s: %s\n", fd.Name(), cell(resp, fd))
+			}
+		}
+		return nil
+	}
+
+	// A Get response wraps the entity in a single field; show the entity.
+	if len(fields) == 1 && isPlainMessage(fields[0]) {
+		resp = resp.Get(fields[0]).Message()
+		fields = populated(resp)
+	}
+
+	for _, fd := range fields {
+		fmt.Fprintf(tw, "%s\t%s\n", strings.ToUpper(string(fd.Name())), cell(resp, fd))
+	}
+	return tw.Flush()
+}
+
+func populated(m protoreflect.Message) []protoreflect.FieldDescriptor {
+	var res []protoreflect.FieldDescriptor
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if m.Has(fd) || !fd.HasPresence() && !fd.IsList() && !fd.IsMap() {
+			res = append(res, fd)
+		}
+	}
+	return res
+}
+
+func firstMessageList(m protoreflect.Message) protoreflect.FieldDescriptor {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() && fd.Kind() == protoreflect.MessageKind {
+			return fd
+		}
+	}
+	return nil
+}
+
+func isPlainMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() &&
+		fd.Message().FullName() != timestampName
+}
+
+func cell(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if !m.Has(fd) && fd.HasPresence() {
+		return "-"
+	}
+
+	v := m.Get(fd)
+	if fd.IsList() {
+		list := v.List()
+		parts := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			parts = append(parts, scalar(fd, list.Get(i)))
+		}
+		return strings.Join(parts, ",")
+	}
+
+	return scalar(fd, v)
+}
+
+func scalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return enumFlagName(ev)
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() == timestampName {
+			return timestampOf(v.Message()).Format(time.RFC3339)
+		}
+		b, _ := protojson.Marshal(v.Message().Interface())
+		return string(b)
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%x", v.Bytes())
+	}
+
+	return v.String()
+}
+
+func timestampOf(m protoreflect.Message) time.Time {
+	if ts, ok := m.Interface().(*timestamppb.Timestamp); ok {
+		return ts.AsTime()
+	}
+	return time.Time{}
+}
+
+// yamlMessage converts m to a YAML node, keeping the field order of the
+// proto definition.
+func yamlMessage(m protoreflect.Message) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.HasPresence() && !m.Has(fd) {
+			continue
+		}
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: string(fd.Name())},
+			yamlField(fd, m.Get(fd)),
+		)
+	}
+	return node
+}
+
+func yamlField(fd protoreflect.FieldDescriptor, v protoreflect.Value) *yaml.Node {
+	if fd.IsList() {
+		list := v.List()
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		if list.Len() == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		for i := 0; i < list.Len(); i++ {
+			node.Content = append(node.Content, yamlValue(fd, list.Get(i)))
+		}
+		return node
+	}
+	return yamlValue(fd, v)
+}
+
+func yamlValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) *yaml.Node {
+	if fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() != timestampName {
+		return yamlMessage(v.Message())
+	}
+
+	node := &yaml.Node{Kind: yaml.ScalarNode, Value: scalar(fd, v)}
+	if fd.Kind() == protoreflect.StringKind {
+		node.Style = yaml.DoubleQuotedStyle
+	}
+	return node
+}
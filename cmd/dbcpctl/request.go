@@ -0,0 +1,225 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var timestampName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+
+// parseRequest fills req from the method arguments. Every scalar, enum and
+// timestamp field of the request gets a flag of its own; -data takes the
+// whole request as JSON, and flags given next to it win.
+func parseRequest(name string, req protoreflect.Message, args []string) error {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	data := fs.String("data", "", "request as JSON, for fields without a flag")
+
+	var values []*fieldValue
+	fields := req.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !flaggable(fd) {
+			continue
+		}
+		v := &fieldValue{fd: fd}
+		values = append(values, v)
+		fs.Var(v, commandName(string(fd.Name())), usage(fd))
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	if *data != "" {
+		if err := protojson.Unmarshal([]byte(*data), req.Interface()); err != nil {
+			return fmt.Errorf("invalid -data: %w", err)
+		}
+	}
+	for _, v := range values {
+		v.apply(req)
+	}
+
+	return nil
+}
+
+func flaggable(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsMap() {
+		return false
+	}
+	if fd.Kind() == protoreflect.MessageKind {
+		return !fd.IsList() && fd.Message().FullName() == timestampName
+	}
+	return fd.Kind() != protoreflect.BytesKind && fd.Kind() != protoreflect.GroupKind
+}
+
+func usage(fd protoreflect.FieldDescriptor) string {
+	var kind string
+	switch {
+	case fd.Kind() == protoreflect.EnumKind:
+		kind = "one of " + strings.Join(enumNames(fd.Enum()), ", ")
+	case fd.Kind() == protoreflect.MessageKind:
+		kind = "RFC 3339 time"
+	default:
+		kind = fd.Kind().String()
+	}
+	if fd.IsList() {
+		kind += ", repeatable or comma separated"
+	}
+	return kind
+}
+
+// fieldValue is a flag.Value that collects the values of one request
+// field; they are written to the request once all flags are parsed, so
+// that they override -data.
+type fieldValue struct {
+	fd     protoreflect.FieldDescriptor
+	values []protoreflect.Value
+}
+
+func (v *fieldValue) String() string { return "" }
+
+func (v *fieldValue) IsBoolFlag() bool {
+	return v.fd.Kind() == protoreflect.BoolKind && !v.fd.IsList()
+}
+
+func (v *fieldValue) Set(s string) error {
+	parts := []string{s}
+	if v.fd.IsList() {
+		parts = strings.Split(s, ",")
+	}
+
+	for _, p := range parts {
+		val, err := parseScalar(v.fd, strings.TrimSpace(p))
+		if err != nil {
+			return err
+		}
+		if !v.fd.IsList() {
+			v.values = v.values[:0]
+		}
+		v.values = append(v.values, val)
+	}
+
+	return nil
+}
+
+func (v *fieldValue) apply(req protoreflect.Message) {
+	if len(v.values) == 0 {
+		return
+	}
+	if !v.fd.IsList() {
+		req.Set(v.fd, v.values[0])
+		return
+	}
+
+	list := req.NewField(v.fd).List()
+	for _, val := range v.values {
+		list.Append(val)
+	}
+	req.Set(v.fd, protoreflect.ValueOfList(list))
+}
+
+func parseScalar(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.EnumKind:
+		return parseEnum(fd.Enum(), s)
+	case protoreflect.MessageKind:
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), nil
+	}
+
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+}
+
+// parseEnum accepts the full value name as well as its short form:
+// CARGO_STATUS_IN_STORAGE, IN_STORAGE and in-storage are the same value.
+func parseEnum(ed protoreflect.EnumDescriptor, s string) (protoreflect.Value, error) {
+	want := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		ev := values.Get(i)
+		if string(ev.Name()) == want || shortEnumName(ev) == want {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("must be one of %s", strings.Join(enumNames(ed), ", "))
+}
+
+func enumNames(ed protoreflect.EnumDescriptor) []string {
+	var names []string
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		if values.Get(i).Number() == 0 {
+			continue
+		}
+		names = append(names, enumFlagName(values.Get(i)))
+	}
+	return names
+}
+
+func enumFlagName(ev protoreflect.EnumValueDescriptor) string {
+	return strings.ToLower(strings.ReplaceAll(shortEnumName(ev), "_", "-"))
+}
+
+// shortEnumName strips the TYPE_NAME_ prefix the enum values carry.
+func shortEnumName(ev protoreflect.EnumValueDescriptor) string {
+	name := string(ev.Name())
+	values := ev.Parent().(protoreflect.EnumDescriptor).Values()
+	if values.Len() < 2 {
+		return name
+	}
+
+	prefix := string(values.Get(0).Name())
+	for i := 1; i < values.Len(); i++ {
+		prefix = commonPrefix(prefix, string(values.Get(i).Name()))
+	}
+	if i := strings.LastIndex(prefix, "_"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func commonPrefix(a, b string) string {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return a[:i]
+		}
+	}
+	return a[:n]
+}
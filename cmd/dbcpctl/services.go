@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	auditv1 "dbcp/protos/gen/go/audit"
+	berthv1 "dbcp/protos/gen/go/berth"
+	berthschedulev1 "dbcp/protos/gen/go/berthschedule"
+	cargov1 "dbcp/protos/gen/go/cargo"
+	cargotypev1 "dbcp/protos/gen/go/cargotype"
+	operationv1 "dbcp/protos/gen/go/operation"
+	opercargov1 "dbcp/protos/gen/go/opercargo"
+	portcallv1 "dbcp/protos/gen/go/portcall"
+	reportv1 "dbcp/protos/gen/go/report"
+	storagelocv1 "dbcp/protos/gen/go/storageloc"
+	vesselv1 "dbcp/protos/gen/go/vessel"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// files lists the API the CLI wraps. Every service of a file becomes a
+// command named after the file's directory, e.g. "storageloc".
+var files = []protoreflect.FileDescriptor{
+	vesselv1.File_vessel_vessel_proto,
+	cargotypev1.File_cargotype_cargotype_proto,
+	cargov1.File_cargo_cargo_proto,
+	storagelocv1.File_storageloc_storageloc_proto,
+	operationv1.File_operation_operation_proto,
+	opercargov1.File_opercargo_opercargo_proto,
+	reportv1.File_report_report_proto,
+	portcallv1.File_portcall_portcall_proto,
+	berthv1.File_berth_berth_proto,
+	berthschedulev1.File_berthschedule_berthschedule_proto,
+	auditv1.File_audit_audit_proto,
+}
+
+func services() map[string]protoreflect.ServiceDescriptor {
+	res := make(map[string]protoreflect.ServiceDescriptor, len(files))
+	for _, f := range files {
+		if f.Services().Len() > 0 {
+			res[path.Dir(f.Path())] = f.Services().Get(0)
+		}
+	}
+	return res
+}
+
+func serviceNames() []string {
+	names := make([]string, 0, len(files))
+	for name := range services() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func findMethod(sd protoreflect.ServiceDescriptor, name string) (protoreflect.MethodDescriptor, error) {
+	methods := sd.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		md := methods.Get(i)
+		if commandName(string(md.Name())) == name || strings.EqualFold(string(md.Name()), name) {
+			return md, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown method %q of %s", name, sd.FullName())
+}
+
+func fullMethod(md protoreflect.MethodDescriptor) string {
+	return fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name())
+}
+
+// commandName turns a Go or proto identifier into its command line form:
+// AutoPlaceVessel and auto_place_vessel both become auto-place-vessel.
+func commandName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_':
+			b.WriteByte('-')
+		case unicode.IsUpper(r):
+			if i > 0 && name[i-1] != '_' {
+				b.WriteByte('-')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tlsFlags select how the connection to the server is secured.
+type tlsFlags struct {
+	enabled    bool
+	caPath     string
+	certPath   string
+	keyPath    string
+	serverName string
+}
+
+func (f *tlsFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&f.enabled, "tls", false, "connect with TLS; implied by the other -tls flags")
+	fs.StringVar(&f.caPath, "tls-ca", "", "CA to verify the server with instead of the system roots")
+	fs.StringVar(&f.certPath, "tls-cert", "", "client certificate for mutual TLS")
+	fs.StringVar(&f.keyPath, "tls-key", "", "key of the client certificate")
+	fs.StringVar(&f.serverName, "tls-server-name", "", "name to verify the server certificate against")
+}
+
+func (f *tlsFlags) credentials() (credentials.TransportCredentials, error) {
+	if !f.enabled && f.caPath == "" && f.certPath == "" && f.keyPath == "" && f.serverName == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: f.serverName,
+	}
+
+	if f.caPath != "" {
+		pem, err := os.ReadFile(f.caPath)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found", f.caPath)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (f.certPath == "") != (f.keyPath == "") {
+		return nil, errors.New("-tls-cert and -tls-key go together")
+	}
+	if f.certPath != "" {
+		cert, err := tls.LoadX509KeyPair(f.certPath, f.keyPath)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
@@ -2,25 +2,41 @@ package app
 
 import (
 	"context"
+	gatewayapp "dbcp/internal/app/gateway"
 	grpcapp "dbcp/internal/app/grpc"
+	metricsapp "dbcp/internal/app/metrics"
+	"dbcp/internal/config"
+	auditservice "dbcp/internal/services/audit"
+	berthservice "dbcp/internal/services/berth"
+	berthscheduleservice "dbcp/internal/services/berthschedule"
 	cargoservice "dbcp/internal/services/cargo"
 	cargotypeservice "dbcp/internal/services/cargo-type"
 	operationservice "dbcp/internal/services/operation"
 	opercargoservice "dbcp/internal/services/opercargo"
+	portcallservice "dbcp/internal/services/portcall"
 	reportservice "dbcp/internal/services/report"
 	storagelocservice "dbcp/internal/services/storageloc"
 	vesselservice "dbcp/internal/services/vessel"
 	"dbcp/internal/storage/postgresql"
 	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 )
 
 type App struct {
 	GRPCServer *grpcapp.App
+	// HTTPGateway is nil when the gateway is turned off.
+	HTTPGateway *gatewayapp.App
+	// MetricsServer is nil when metrics are turned off.
+	MetricsServer *metricsapp.App
 }
 
 func New(
 	log *slog.Logger,
-	grpcPort int,
+	grpcCfg config.GRPCConfig,
+	httpCfg config.HTTPConfig,
+	metricsCfg config.MetricsConfig,
 	connString string,
 	ctx context.Context,
 ) *App {
@@ -36,8 +52,18 @@ func New(
 	operationService := operationservice.New(log, storage)
 	operCargoService := opercargoservice.New(log, storage)
 	reportService := reportservice.New(log, storage)
+	portCallService := portcallservice.New(log, storage)
+	berthService := berthservice.New(log, storage)
+	berthScheduleService := berthscheduleservice.New(log, storage)
+	auditService := auditservice.New(log, storage)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
 
-	grpcApp := grpcapp.New(
+	grpcApp, err := grpcapp.New(
 		log, 
 		vesselService, 
 		cargoTypeService, 
@@ -46,10 +72,44 @@ func New(
 		operationService,
 		operCargoService,
 		reportService,
-		grpcPort,
+		portCallService,
+		berthService,
+		berthScheduleService,
+		auditService,
+		storage,
+		registry,
+		grpcCfg,
 	)
+	if err != nil {
+		panic(err)
+	}
+
+	var gatewayApp *gatewayapp.App
+	if httpCfg.Port != 0 {
+		// The gateway reaches the gRPC server in process, past its TLS
+		// listener, so it listens with the same certificates and asks its
+		// own clients for the certificate the gRPC server would.
+		conn, err := grpcApp.Dial()
+		if err != nil {
+			panic(err)
+		}
+		gatewayApp, err = gatewayapp.New(log, conn, grpcApp.HTTPTLSConfig(), httpCfg.Port)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	var metricsApp *metricsapp.App
+	if metricsCfg.Port != 0 {
+		metricsApp, err = metricsapp.New(log, registry, storage, metricsCfg.Port)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	return &App{
 		GRPCServer: grpcApp,
+		HTTPGateway: gatewayApp,
+		MetricsServer: metricsApp,
 	}
 }
\ No newline at end of file
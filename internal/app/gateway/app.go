@@ -0,0 +1,168 @@
+package gatewayapp
+
+import (
+	"context"
+	"crypto/tls"
+	"dbcp/internal/lib/logger/sl"
+	auditv1 "dbcp/protos/gen/go/audit"
+	berthv1 "dbcp/protos/gen/go/berth"
+	berthschedulev1 "dbcp/protos/gen/go/berthschedule"
+	cargov1 "dbcp/protos/gen/go/cargo"
+	cargotypev1 "dbcp/protos/gen/go/cargotype"
+	operationv1 "dbcp/protos/gen/go/operation"
+	opercargov1 "dbcp/protos/gen/go/opercargo"
+	portcallv1 "dbcp/protos/gen/go/portcall"
+	reportv1 "dbcp/protos/gen/go/report"
+	storagelocv1 "dbcp/protos/gen/go/storageloc"
+	vesselv1 "dbcp/protos/gen/go/vessel"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// forwardedHeaders are passed to the gRPC API as metadata under their
+// lower-case name, in addition to the Grpc-Metadata- headers the gateway
+// forwards by default. Authorization is always forwarded.
+var forwardedHeaders = map[string]bool{
+	"X-Actor":      true,
+	"X-Api-Key":    true,
+	"X-Request-Id": true,
+}
+
+type registerFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+var handlers = []registerFunc{
+	vesselv1.RegisterVesselServiceHandler,
+	cargotypev1.RegisterCargoTypeServiceHandler,
+	cargov1.RegisterCargoServiceHandler,
+	storagelocv1.RegisterStorageLocationServiceHandler,
+	operationv1.RegisterOperationServiceHandler,
+	opercargov1.RegisterOperationCargoServiceHandler,
+	reportv1.RegisterReportServiceHandler,
+	portcallv1.RegisterPortCallServiceHandler,
+	berthv1.RegisterBerthServiceHandler,
+	berthschedulev1.RegisterBerthScheduleServiceHandler,
+	auditv1.RegisterAuditServiceHandler,
+}
+
+// App serves the gRPC API as HTTP/JSON. Every call is proxied to the gRPC
+// server, so it passes the same interceptors and its status code is
+// mapped to the matching HTTP status.
+type App struct {
+	log        *slog.Logger
+	conn       *grpc.ClientConn
+	httpServer *http.Server
+	port       int
+}
+
+// New builds the gateway on top of conn, which it takes over and closes
+// on Stop. With tlsConfig set the gateway serves HTTPS only.
+func New(
+	log *slog.Logger,
+	conn *grpc.ClientConn,
+	tlsConfig *tls.Config,
+	port int,
+) (*App, error) {
+	const op = "gatewayapp.New"
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	for _, register := range handlers {
+		if err := register(context.Background(), mux, conn); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return &App{
+		log:  log,
+		conn: conn,
+		httpServer: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+			TLSConfig:         tlsConfig,
+		},
+		port: port,
+	}, nil
+}
+
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func (a *App) Run() error {
+	const op = "gatewayapp.Run"
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", a.port))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tlsEnabled := a.httpServer.TLSConfig != nil
+	a.log.Info("http gateway started",
+		slog.String("addr", l.Addr().String()),
+		slog.Bool("tls", tlsEnabled),
+	)
+
+	if tlsEnabled {
+		// The certificates come from TLSConfig, not from files.
+		err = a.httpServer.ServeTLS(l, "", "")
+	} else {
+		err = a.httpServer.Serve(l)
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (a *App) Stop(ctx context.Context) {
+	const op = "gatewayapp.Stop"
+
+	log := a.log.With(slog.String("op", op))
+	log.Info("stopping http gateway", slog.Int("port", a.port))
+
+	if err := a.httpServer.Shutdown(ctx); err != nil {
+		log.Error("failed to stop http gateway", sl.Err(err))
+	}
+	a.conn.Close()
+}
+
+func incomingHeader(key string) (string, bool) {
+	if forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)] {
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader returns the request ID as a plain header, so HTTP clients
+// see the same X-Request-Id as gRPC clients.
+func outgoingHeader(key string) (string, bool) {
+	if k := textproto.CanonicalMIMEHeaderKey(key); forwardedHeaders[k] {
+		return k, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
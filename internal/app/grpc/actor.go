@@ -0,0 +1,65 @@
+package grpcapp
+
+import (
+	"context"
+	"dbcp/internal/lib/actor"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// actorHeader is the metadata key callers put their name in when
+	// authentication is off. Anyone can put any name there, so the audit
+	// log keeps it as the claimed actor and the actor stays anonymous.
+	actorHeader = "x-actor"
+
+	// maxActorLen is the size of the actor columns of the audit log in
+	// characters.
+	maxActorLen = 200
+)
+
+func actorInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withActor(ctx), req)
+}
+
+func actorStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: withActor(ss.Context())})
+}
+
+func withActor(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(actorHeader); len(v) > 0 {
+			ctx = actor.WithClaimed(ctx, truncateActor(v[0]))
+		}
+	}
+
+	return ctx
+}
+
+// truncateActor drops invalid UTF-8 from the name and cuts it to
+// maxActorLen characters without splitting a multi-byte rune.
+func truncateActor(name string) string {
+	name = strings.ToValidUTF8(name, "")
+
+	n := 0
+	for i := range name {
+		if n == maxActorLen {
+			return name[:i]
+		}
+		n++
+	}
+
+	return name
+}
@@ -1,24 +1,41 @@
 package grpcapp
 
 import (
+	"dbcp/internal/config"
+	"dbcp/internal/grpc/audit"
+	"dbcp/internal/grpc/berth"
+	"dbcp/internal/grpc/berthschedule"
 	"dbcp/internal/grpc/cargo"
 	cargotype "dbcp/internal/grpc/cargo-type"
 	"dbcp/internal/grpc/operation"
 	"dbcp/internal/grpc/opercargo"
+	"dbcp/internal/grpc/portcall"
 	"dbcp/internal/grpc/report"
 	"dbcp/internal/grpc/storageloc"
 	"dbcp/internal/grpc/vessel"
+	"dbcp/internal/lib/logger/sl"
 	"fmt"
 	"log/slog"
 	"net"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
 )
 
 type App struct {
 	log 		*slog.Logger
 	gRPCServer 	*grpc.Server
 	port 		int
+	timeouts 	timeouts
+	health 		*healthChecker
+	auth 		*authenticator
+	authz 		*authorizer
+	metrics 	*rpcMetrics
+	// certs is nil when the server listens without TLS.
+	certs 		*certReloader
+	loopback 	*loopbackListener
 }
 
 func New(
@@ -30,9 +47,82 @@ func New(
 	operationService operation.Operation,
 	operCargoService opercargo.OperationCargo,
 	reportService report.Report,
-	port int,
-) *App {
-	gRPCServer := grpc.NewServer()
+	portCallService portcall.PortCall,
+	berthService berth.Berth,
+	berthScheduleService berthschedule.BerthSchedule,
+	auditService audit.Audit,
+	pinger Pinger,
+	registry prometheus.Registerer,
+	cfg config.GRPCConfig,
+) (*App, error) {
+	const op = "grpcapp.New"
+
+	a := &App{
+		log: log,
+		port: cfg.Port,
+		timeouts: timeouts{
+			def: cfg.Timeout,
+			methods: cfg.MethodTimeouts,
+		},
+		loopback: newLoopbackListener(),
+	}
+
+	metrics, err := newRPCMetrics(registry)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	a.metrics = metrics
+
+	var opts []grpc.ServerOption
+	if cfg.TLS.Enabled() {
+		certs, err := newCertReloader(log, cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		a.certs = certs
+		opts = append(opts, grpc.Creds(serverCreds{
+			TransportCredentials: credentials.NewTLS(certs.tlsConfig("h2")),
+		}))
+	}
+
+	identify := grpc.UnaryServerInterceptor(actorInterceptor)
+	identifyStream := grpc.StreamServerInterceptor(actorStreamInterceptor)
+	if cfg.Auth.Enabled {
+		au, err := newAuthenticator(cfg.Auth, cfg.TLS.Enabled() && cfg.TLS.ClientCAPath != "")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		az, err := newAuthorizer(cfg.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		a.auth, a.authz = au, az
+		identify, identifyStream = a.authInterceptor, a.authStreamInterceptor
+	}
+
+	// Recovery comes first so that a panic anywhere in the chain, not
+	// only in the handler, is turned into codes.Internal; it logs the
+	// panic itself. Metrics come next so that every other call is counted.
+	// The caller is identified before logging so the request logger can
+	// carry it. The deadline is set last, so it covers the handler alone.
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(
+			a.recoveryInterceptor,
+			a.metricsInterceptor,
+			identify,
+			a.loggingInterceptor,
+			a.timeoutInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			a.recoveryStreamInterceptor,
+			a.metricsStreamInterceptor,
+			identifyStream,
+			a.loggingStreamInterceptor,
+			a.timeoutStreamInterceptor,
+		),
+	)
+	gRPCServer := grpc.NewServer(opts...)
+	a.gRPCServer = gRPCServer
 
 	vessel.Register(gRPCServer, vesselService)
 	cargotype.Register(gRPCServer, cargoTypeService)
@@ -41,12 +131,18 @@ func New(
 	operation.Register(gRPCServer, operationService)
 	opercargo.Register(gRPCServer, operCargoService)
 	report.Register(gRPCServer, reportService)
+	portcall.Register(gRPCServer, portCallService)
+	berth.Register(gRPCServer, berthService)
+	berthschedule.Register(gRPCServer, berthScheduleService)
+	audit.Register(gRPCServer, auditService)
 
-	return &App{
-		log: log,
-		gRPCServer: gRPCServer,
-		port: port,
+	a.health = newHealthChecker(log, pinger, cfg.HealthCheckInterval, gRPCServer)
+
+	if cfg.Reflection {
+		reflection.Register(gRPCServer)
 	}
+
+	return a, nil
 }
 
 func (a *App) MustRun() {
@@ -63,7 +159,21 @@ func (a *App) Run() error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	a.log.Info("grpc server started", slog.String("addr", l.Addr().String()))
+	a.log.Info("grpc server started",
+		slog.String("addr", l.Addr().String()),
+		slog.Bool("tls", a.certs != nil),
+	)
+
+	a.health.Start()
+	if a.certs != nil {
+		a.certs.Start()
+	}
+
+	go func() {
+		if err := a.gRPCServer.Serve(a.loopback); err != nil {
+			a.log.Error("loopback listener stopped", slog.String("op", op), sl.Err(err))
+		}
+	}()
 
 	if err := a.gRPCServer.Serve(l); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -78,5 +188,9 @@ func (a *App) Stop() {
 	a.log.With(slog.String("op", op)).
 		Info("stopping gRPC server", slog.Int("port", a.port))
 
+	a.health.Stop()
+	if a.certs != nil {
+		a.certs.Stop()
+	}
 	a.gRPCServer.GracefulStop()
 }
\ No newline at end of file
@@ -0,0 +1,328 @@
+package grpcapp
+
+import (
+	"context"
+	"crypto/subtle"
+	"dbcp/internal/config"
+	"dbcp/internal/lib/actor"
+	"dbcp/internal/lib/auth"
+	"dbcp/internal/lib/logger/sl"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyHeader        = "x-api-key"
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+
+	jwtLeeway = 30 * time.Second
+)
+
+// authExempt lists the services that answer without credentials, so that
+// probes and tooling keep working.
+var authExempt = map[string]bool{
+	healthpb.Health_ServiceDesc.ServiceName:                    true,
+	reflectionv1.ServerReflection_ServiceDesc.ServiceName:      true,
+	reflectionv1alpha.ServerReflection_ServiceDesc.ServiceName: true,
+}
+
+var (
+	errMissingCredentials = errors.New("missing credentials")
+	errInvalidCredentials = errors.New("invalid credentials")
+)
+
+// authInterceptor rejects calls without valid credentials or without the
+// role the method needs, and puts the authenticated principal into the
+// context. It takes the place of actorInterceptor: the principal, not the
+// x-actor header, is recorded in the audit log.
+func (a *App) authInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *App) authStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate checks the caller of fullMethod. req is nil for streams,
+// whose field rules therefore cannot apply.
+func (a *App) authenticate(ctx context.Context, fullMethod string, req any) (context.Context, error) {
+	if authExempt[serviceName(fullMethod)] {
+		return ctx, nil
+	}
+
+	p, err := a.auth.authenticate(ctx)
+	if err != nil {
+		a.log.Warn("unauthenticated request",
+			slog.String("method", fullMethod),
+			sl.Err(err),
+		)
+		if errors.Is(err, errMissingCredentials) {
+			return nil, status.Error(codes.Unauthenticated, errMissingCredentials.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, errInvalidCredentials.Error())
+	}
+
+	if err := a.authz.authorize(p, fullMethod, req); err != nil {
+		a.log.Warn("permission denied",
+			slog.String("method", fullMethod),
+			slog.String("principal", p.Name),
+			slog.String("role", p.Role.String()),
+			sl.Err(err),
+		)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	ctx = auth.WithPrincipal(ctx, p)
+	return actor.WithActor(ctx, p.Name), nil
+}
+
+// serviceName returns "package.Service" of "/package.Service/Method".
+func serviceName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
+type apiKey struct {
+	name string
+	key  []byte
+	role auth.Role
+}
+
+// authenticator checks the credentials a call carries: an API key in the
+// x-api-key header, a JWT in the authorization header or, with neither,
+// a client certificate verified during the TLS handshake.
+type authenticator struct {
+	apiKeys []apiKey
+	// jwtKey verifies token signatures; nil when tokens are not accepted.
+	jwtKey    any
+	jwtParser *jwt.Parser
+	// certRoles holds the roles of client certificates by name.
+	certRoles map[string]auth.Role
+}
+
+// newAuthenticator builds the authenticator for cfg. clientCerts tells
+// whether the server verifies client certificates, which may then be the
+// only credentials configured.
+func newAuthenticator(cfg config.AuthConfig, clientCerts bool) (*authenticator, error) {
+	const op = "grpcapp.newAuthenticator"
+
+	au := &authenticator{certRoles: make(map[string]auth.Role, len(cfg.ClientCertRoles))}
+	for _, k := range cfg.APIKeys {
+		if k.Name == "" || k.Key == "" {
+			return nil, fmt.Errorf("%s: api key needs a name and a key", op)
+		}
+		role := auth.RoleViewer
+		if k.Role != "" {
+			r, err := auth.ParseRole(k.Role)
+			if err != nil {
+				return nil, fmt.Errorf("%s: api key %s: %w", op, k.Name, err)
+			}
+			role = r
+		}
+		au.apiKeys = append(au.apiKeys, apiKey{name: k.Name, key: []byte(k.Key), role: role})
+	}
+
+	var methods []string
+	switch {
+	case cfg.JWT.Secret != "" && cfg.JWT.PublicKeyPath != "":
+		return nil, fmt.Errorf("%s: jwt secret and public key are mutually exclusive", op)
+	case cfg.JWT.Secret != "":
+		au.jwtKey = []byte(cfg.JWT.Secret)
+		methods = []string{"HS256", "HS384", "HS512"}
+	case cfg.JWT.PublicKeyPath != "":
+		key, m, err := loadPublicKey(cfg.JWT.PublicKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		au.jwtKey, methods = key, m
+	}
+
+	for name, roleName := range cfg.ClientCertRoles {
+		r, err := auth.ParseRole(roleName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: client cert %s: %w", op, name, err)
+		}
+		au.certRoles[name] = r
+	}
+	if len(au.certRoles) > 0 && !clientCerts {
+		return nil, fmt.Errorf("%s: client cert roles need tls with a client ca", op)
+	}
+
+	if len(au.apiKeys) == 0 && au.jwtKey == nil && !clientCerts {
+		return nil, fmt.Errorf("%s: auth is enabled but no api keys, jwt key or client ca are configured", op)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if cfg.JWT.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.JWT.Issuer))
+	}
+	if cfg.JWT.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.JWT.Audience))
+	}
+	au.jwtParser = jwt.NewParser(opts...)
+
+	return au, nil
+}
+
+func loadPublicKey(path string) (any, []string, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"ES256", "ES384", "ES512"}, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"EdDSA"}, nil
+	}
+
+	return nil, nil, fmt.Errorf("%s: unsupported public key", path)
+}
+
+func (au *authenticator) authenticate(ctx context.Context) (auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if v := md.Get(apiKeyHeader); len(v) > 0 {
+		return au.apiKey(v[0])
+	}
+	if v := md.Get(authorizationHeader); len(v) > 0 {
+		token, ok := strings.CutPrefix(v[0], bearerPrefix)
+		if !ok {
+			return auth.Principal{}, fmt.Errorf("%w: not a bearer token", errInvalidCredentials)
+		}
+		return au.token(token)
+	}
+	if p, ok := au.clientCert(ctx); ok {
+		return p, nil
+	}
+
+	return auth.Principal{}, errMissingCredentials
+}
+
+// clientCert returns the caller named by the client certificate of the
+// connection. ok is false when the connection has no verified one.
+func (au *authenticator) clientCert(ctx context.Context) (auth.Principal, bool) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return auth.Principal{}, false
+	}
+	info, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return auth.Principal{}, false
+	}
+
+	leaf := info.State.VerifiedChains[0][0]
+	name := leaf.Subject.CommonName
+	if name == "" && len(leaf.DNSNames) > 0 {
+		name = leaf.DNSNames[0]
+	}
+	if name == "" {
+		return auth.Principal{}, false
+	}
+
+	role, ok := au.certRoles[name]
+	if !ok {
+		role = auth.RoleViewer
+	}
+
+	return auth.Principal{Name: name, Method: auth.MethodClientCert, Role: role}, true
+}
+
+func (au *authenticator) apiKey(key string) (auth.Principal, error) {
+	// Every key is compared, so the time taken does not tell which one
+	// came close.
+	var found *apiKey
+	for i, k := range au.apiKeys {
+		if subtle.ConstantTimeCompare(k.key, []byte(key)) == 1 {
+			found = &au.apiKeys[i]
+		}
+	}
+	if found == nil {
+		return auth.Principal{}, fmt.Errorf("%w: unknown api key", errInvalidCredentials)
+	}
+
+	return auth.Principal{Name: found.name, Method: auth.MethodAPIKey, Role: found.role}, nil
+}
+
+func (au *authenticator) token(raw string) (auth.Principal, error) {
+	if au.jwtKey == nil {
+		return auth.Principal{}, fmt.Errorf("%w: tokens are not accepted", errInvalidCredentials)
+	}
+
+	var claims tokenClaims
+	_, err := au.jwtParser.ParseWithClaims(raw, &claims, func(*jwt.Token) (any, error) {
+		return au.jwtKey, nil
+	})
+	if err != nil {
+		return auth.Principal{}, fmt.Errorf("%w: %w", errInvalidCredentials, err)
+	}
+	if claims.Subject == "" {
+		return auth.Principal{}, fmt.Errorf("%w: token has no subject", errInvalidCredentials)
+	}
+
+	return auth.Principal{Name: claims.Subject, Method: auth.MethodJWT, Role: claims.role()}, nil
+}
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// role returns the highest of the roles the token lists. Names unknown to
+// the API are skipped, so a token shared with other systems still works.
+func (c tokenClaims) role() auth.Role {
+	role := auth.RoleViewer
+	for _, name := range c.Roles {
+		if r, err := auth.ParseRole(name); err == nil && r > role {
+			role = r
+		}
+	}
+	return role
+}
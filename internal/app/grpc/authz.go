@@ -0,0 +1,120 @@
+package grpcapp
+
+import (
+	"dbcp/internal/config"
+	"dbcp/internal/lib/auth"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// policy is the lowest role allowed to call a method, raised by fields
+// for requests that set them.
+type policy struct {
+	role   auth.Role
+	fields map[protoreflect.Name]auth.Role
+}
+
+// authorizer decides whether an authenticated caller may call a method.
+type authorizer struct {
+	def      auth.Role
+	policies map[string]policy
+}
+
+// newAuthorizer builds the policy table from cfg. Method, service and
+// field names are checked against the registered API, so that a typo
+// fails the start instead of leaving a method at the default role.
+func newAuthorizer(cfg config.AuthConfig) (*authorizer, error) {
+	const op = "grpcapp.newAuthorizer"
+
+	def, err := auth.ParseRole(cfg.DefaultRole)
+	if err != nil {
+		return nil, fmt.Errorf("%s: default role: %w", op, err)
+	}
+
+	az := &authorizer{def: def, policies: make(map[string]policy, len(cfg.Policies))}
+	for name, pc := range cfg.Policies {
+		p, err := newPolicy(name, pc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: policy %s: %w", op, name, err)
+		}
+		az.policies[name] = p
+	}
+
+	return az, nil
+}
+
+func newPolicy(name string, pc config.PolicyConfig) (policy, error) {
+	role, err := auth.ParseRole(pc.Role)
+	if err != nil {
+		return policy{}, err
+	}
+	p := policy{role: role}
+
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(
+		strings.ReplaceAll(strings.TrimPrefix(name, "/"), "/", "."),
+	))
+	if err != nil {
+		return policy{}, fmt.Errorf("unknown method or service")
+	}
+
+	var md protoreflect.MethodDescriptor
+	switch d := d.(type) {
+	case protoreflect.MethodDescriptor:
+		md = d
+	case protoreflect.ServiceDescriptor:
+		if len(pc.Fields) > 0 {
+			return policy{}, fmt.Errorf("field rules need a method")
+		}
+		return p, nil
+	default:
+		return policy{}, fmt.Errorf("unknown method or service")
+	}
+
+	p.fields = make(map[protoreflect.Name]auth.Role, len(pc.Fields))
+	for field, roleName := range pc.Fields {
+		if md.Input().Fields().ByName(protoreflect.Name(field)) == nil {
+			return policy{}, fmt.Errorf("%s has no field %s", md.Input().FullName(), field)
+		}
+		r, err := auth.ParseRole(roleName)
+		if err != nil {
+			return policy{}, fmt.Errorf("field %s: %w", field, err)
+		}
+		p.fields[protoreflect.Name(field)] = r
+	}
+
+	return p, nil
+}
+
+// authorize returns an error telling the caller which role it lacks.
+func (az *authorizer) authorize(p auth.Principal, fullMethod string, req any) error {
+	pol, ok := az.policies[fullMethod]
+	if !ok {
+		pol, ok = az.policies["/"+serviceName(fullMethod)]
+	}
+	if !ok {
+		pol = policy{role: az.def}
+	}
+
+	if p.Role < pol.role {
+		return fmt.Errorf("requires role %s", pol.role)
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok || len(pol.fields) == 0 {
+		return nil
+	}
+
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for name, role := range pol.fields {
+		if fd := fields.ByName(name); fd != nil && m.Has(fd) && p.Role < role {
+			return fmt.Errorf("setting %s requires role %s", name, role)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package grpcapp
+
+import (
+	"context"
+	"dbcp/internal/lib/logger/sl"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const defaultHealthCheckInterval = 5 * time.Second
+
+// Pinger reports whether the database the services depend on is
+// reachable.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// healthChecker serves grpc.health.v1 and keeps the status of every
+// registered service in line with periodic database pings, so that load
+// balancers stop routing to an instance that has lost its database.
+type healthChecker struct {
+	log      *slog.Logger
+	pinger   Pinger
+	interval time.Duration
+	server   *health.Server
+	services []string
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// newHealthChecker registers the health service on gRPCServer. It must be
+// called after the other services are registered, as their names are
+// taken from the server.
+func newHealthChecker(
+	log *slog.Logger,
+	pinger Pinger,
+	interval time.Duration,
+	gRPCServer *grpc.Server,
+) *healthChecker {
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	h := &healthChecker{
+		log:      log,
+		pinger:   pinger,
+		interval: interval,
+		server:   health.NewServer(),
+		services: []string{""},
+		stop:     make(chan struct{}),
+	}
+
+	for name := range gRPCServer.GetServiceInfo() {
+		h.services = append(h.services, name)
+	}
+
+	// Nothing is served until the first ping succeeds.
+	h.set(healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(gRPCServer, h.server)
+
+	return h
+}
+
+// Start runs the checks in the background until Stop is called.
+func (h *healthChecker) Start() {
+	go h.run()
+}
+
+// Stop ends the checks and reports every service as not serving, so that
+// clients drain before the server shuts down. The health server ignores
+// updates after Shutdown, so a check still in flight cannot undo it.
+func (h *healthChecker) Stop() {
+	h.once.Do(func() {
+		close(h.stop)
+		h.server.Shutdown()
+	})
+}
+
+func (h *healthChecker) run() {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	serving := false
+	for {
+		err := h.check()
+		if ok := err == nil; ok != serving {
+			if ok {
+				h.log.Info("database is reachable, serving")
+				h.set(healthpb.HealthCheckResponse_SERVING)
+			} else {
+				h.log.Warn("database is unreachable, not serving", sl.Err(err))
+				h.set(healthpb.HealthCheckResponse_NOT_SERVING)
+			}
+			serving = ok
+		}
+
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthChecker) check() error {
+	ctx, cancel := context.WithTimeout(context.Background(), h.interval)
+	defer cancel()
+
+	return h.pinger.Ping(ctx)
+}
+
+func (h *healthChecker) set(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, name := range h.services {
+		h.server.SetServingStatus(name, status)
+	}
+}
@@ -0,0 +1,121 @@
+package grpcapp
+
+import (
+	"context"
+	"crypto/rand"
+	"dbcp/internal/lib/actor"
+	"dbcp/internal/lib/logger/sl"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// requestIDHeader is the metadata key that carries the request ID. A
+	// caller may set it to correlate its own logs with ours; the ID is
+	// echoed back in the response header either way.
+	requestIDHeader = "x-request-id"
+
+	maxRequestIDLen = 128
+)
+
+// loggingInterceptor assigns the request ID, puts a logger carrying it
+// into the context for the services to use and logs the outcome of the
+// call.
+func (a *App) loggingInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, log := a.requestLogger(ctx, info.FullMethod)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID(ctx)))
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logResult(log, start, err)
+
+	return resp, err
+}
+
+func (a *App) loggingStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, log := a.requestLogger(ss.Context(), info.FullMethod)
+	_ = ss.SetHeader(metadata.Pairs(requestIDHeader, requestID(ctx)))
+
+	start := time.Now()
+	err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	logResult(log, start, err)
+
+	return err
+}
+
+type requestIDKey struct{}
+
+func (a *App) requestLogger(ctx context.Context, method string) (context.Context, *slog.Logger) {
+	id := incomingRequestID(ctx)
+	if id == "" {
+		id = newRequestID()
+	}
+
+	log := a.log.With(
+		slog.String("request_id", id),
+		slog.String("method", method),
+		slog.String("actor", actor.FromContext(ctx)),
+	)
+	if claimed := actor.ClaimedFromContext(ctx); claimed != "" {
+		log = log.With(slog.String("claimed_actor", claimed))
+	}
+
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return sl.WithLogger(ctx, log), log
+}
+
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	v := md.Get(requestIDHeader)
+	if len(v) == 0 || len(v[0]) > maxRequestIDLen {
+		return ""
+	}
+
+	return v[0]
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func logResult(log *slog.Logger, start time.Time, err error) {
+	code := status.Code(err)
+	attrs := []any{
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+
+	switch code {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+		log.Error("request failed", append(attrs, sl.Err(err))...)
+	default:
+		log.Info("request handled", attrs...)
+	}
+}
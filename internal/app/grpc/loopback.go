@@ -0,0 +1,65 @@
+package grpcapp
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const loopbackBufferSize = 1 << 20
+
+// loopbackListener carries in-process connections, such as those of the
+// HTTP gateway, to the gRPC server without a network hop. They skip TLS:
+// nothing outside the process can reach them.
+type loopbackListener struct {
+	*bufconn.Listener
+}
+
+func newLoopbackListener() *loopbackListener {
+	return &loopbackListener{Listener: bufconn.Listen(loopbackBufferSize)}
+}
+
+func (l *loopbackListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return loopbackConn{Conn: conn}, nil
+}
+
+// loopbackConn marks the server end of a loopback connection.
+type loopbackConn struct {
+	net.Conn
+}
+
+// Dial returns a client connection to the server through the loopback
+// listener. Calls made on it pass the same interceptors as any other.
+func (a *App) Dial() (*grpc.ClientConn, error) {
+	return grpc.NewClient("passthrough:///loopback",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return a.loopback.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+// serverCreds does the TLS handshake on network connections and lets
+// loopback connections through as they are.
+type serverCreds struct {
+	credentials.TransportCredentials
+}
+
+func (c serverCreds) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if _, ok := conn.(loopbackConn); ok {
+		return insecure.NewCredentials().ServerHandshake(conn)
+	}
+	return c.TransportCredentials.ServerHandshake(conn)
+}
+
+func (c serverCreds) Clone() credentials.TransportCredentials {
+	return serverCreds{TransportCredentials: c.TransportCredentials.Clone()}
+}
@@ -0,0 +1,84 @@
+package grpcapp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// rpcMetrics counts the calls the server handled and how long they took,
+// by method and status code.
+type rpcMetrics struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func newRPCMetrics(reg prometheus.Registerer) (*rpcMetrics, error) {
+	m := &rpcMetrics{
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "RPCs completed on the server, regardless of success or failure.",
+		}, []string{"grpc_type", "grpc_service", "grpc_method", "grpc_code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Time taken by the server to handle RPCs.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_type", "grpc_service", "grpc_method"}),
+	}
+
+	for _, c := range []prometheus.Collector{m.handled, m.duration} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+func (m *rpcMetrics) observe(typ, fullMethod string, start time.Time, err error) {
+	service := serviceName(fullMethod)
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	m.handled.WithLabelValues(typ, service, method, status.Code(err).String()).Inc()
+	m.duration.WithLabelValues(typ, service, method).Observe(time.Since(start).Seconds())
+}
+
+// metricsInterceptor runs first in the chain, so that calls rejected by
+// authentication or cut short by a panic are counted too.
+func (a *App) metricsInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	a.metrics.observe("unary", info.FullMethod, start, err)
+
+	return resp, err
+}
+
+func (a *App) metricsStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	typ := "bidi_stream"
+	switch {
+	case info.IsClientStream && !info.IsServerStream:
+		typ = "client_stream"
+	case !info.IsClientStream && info.IsServerStream:
+		typ = "server_stream"
+	}
+
+	start := time.Now()
+	err := handler(srv, ss)
+	a.metrics.observe(typ, info.FullMethod, start, err)
+
+	return err
+}
@@ -0,0 +1,53 @@
+package grpcapp
+
+import (
+	"context"
+	"dbcp/internal/lib/logger/sl"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryInterceptor turns a panic in a handler into codes.Internal
+// instead of letting it take the whole server down.
+func (a *App) recoveryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = a.recovered(ctx, p)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+func (a *App) recoveryStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = a.recovered(ss.Context(), p)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+func (a *App) recovered(ctx context.Context, p any) error {
+	sl.FromContext(ctx, a.log).Error("panic while handling request",
+		slog.Any("panic", p),
+		slog.String("stack", string(debug.Stack())),
+	)
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,18 @@
+package grpcapp
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// serverStream overrides the context of a wrapped stream, so that stream
+// interceptors can pass values down to the handler.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
\ No newline at end of file
@@ -0,0 +1,91 @@
+package grpcapp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// timeouts holds the server-side deadline of every RPC. A method override
+// wins over a service override, which wins over the default. Zero means
+// no deadline.
+type timeouts struct {
+	def     time.Duration
+	methods map[string]time.Duration
+}
+
+func (t timeouts) forMethod(fullMethod string) time.Duration {
+	if d, ok := t.override(fullMethod); ok {
+		return d
+	}
+	return t.def
+}
+
+func (t timeouts) override(fullMethod string) (time.Duration, bool) {
+	if d, ok := t.methods[fullMethod]; ok {
+		return d, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if d, ok := t.methods[fullMethod[:i]]; ok {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// timeoutInterceptor bounds the handler with the configured deadline. The
+// deadline travels in ctx down to the pgx queries, so a slow query is
+// cancelled and its connection returned to the pool. A shorter deadline
+// set by the client is kept.
+func (a *App) timeoutInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	d := a.timeouts.forMethod(info.FullMethod)
+	if d <= 0 {
+		return handler(ctx, req)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	resp, err := handler(ctx, req)
+	return resp, deadlineError(ctx, err)
+}
+
+// timeoutStreamInterceptor applies only explicit overrides: streams such
+// as health Watch are long-lived and must not inherit the default.
+func (a *App) timeoutStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	d, _ := a.timeouts.override(info.FullMethod)
+	if d <= 0 {
+		return handler(srv, ss)
+	}
+
+	ctx, cancel := context.WithTimeout(ss.Context(), d)
+	defer cancel()
+
+	err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	return deadlineError(ctx, err)
+}
+
+// deadlineError reports a failure caused by the expired deadline as
+// codes.DeadlineExceeded rather than the codes.Internal the handlers map
+// storage errors to.
+func deadlineError(ctx context.Context, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+	return err
+}
@@ -0,0 +1,177 @@
+package grpcapp
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"dbcp/internal/config"
+	"dbcp/internal/lib/logger/sl"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+const defaultTLSReloadInterval = 30 * time.Second
+
+// certReloader serves the server certificate, and the client CA for
+// mutual TLS, from files that may be replaced while the server runs. They
+// are read again on SIGHUP and when a file changes; a reload that fails
+// keeps the previous certificates in place.
+type certReloader struct {
+	log      *slog.Logger
+	cfg      config.TLSConfig
+	interval time.Duration
+
+	current atomic.Pointer[tls.Config]
+	// stamp identifies the versions of the files that were loaded.
+	stamp string
+
+	stop chan struct{}
+	once sync.Once
+}
+
+func newCertReloader(log *slog.Logger, cfg config.TLSConfig) (*certReloader, error) {
+	const op = "grpcapp.newCertReloader"
+
+	if cfg.CertPath == "" || cfg.KeyPath == "" {
+		return nil, fmt.Errorf("%s: tls needs both cert_path and key_path", op)
+	}
+
+	interval := cfg.ReloadInterval
+	if interval <= 0 {
+		interval = defaultTLSReloadInterval
+	}
+
+	r := &certReloader{
+		log:      log,
+		cfg:      cfg,
+		interval: interval,
+		stop:     make(chan struct{}),
+	}
+	if err := r.reload(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r, nil
+}
+
+// tlsConfig returns a listener config that hands every handshake the
+// certificates loaded last. GetConfigForClient bypasses the ALPN setup of
+// whoever serves the connection, so the protocols to offer are given here.
+func (r *certReloader) tlsConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := r.current.Load().Clone()
+			cfg.NextProtos = nextProtos
+			return cfg, nil
+		},
+	}
+}
+
+// HTTPTLSConfig returns the TLS config the HTTP gateway listens with, so
+// that it asks for the same certificates as the gRPC server. It is nil
+// when the server listens without TLS.
+func (a *App) HTTPTLSConfig() *tls.Config {
+	if a.certs == nil {
+		return nil
+	}
+	return a.certs.tlsConfig("h2", "http/1.1")
+}
+
+func (r *certReloader) reload() error {
+	stamp := r.fileStamp()
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertPath, r.cfg.KeyPath)
+	if err != nil {
+		return err
+	}
+
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if r.cfg.ClientCAPath != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCAPath)
+		if err != nil {
+			return err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return errors.New(r.cfg.ClientCAPath + ": no certificates found")
+		}
+
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		if r.cfg.ClientCertOptional {
+			cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	r.current.Store(cfg)
+	r.stamp = stamp
+
+	return nil
+}
+
+// fileStamp changes whenever one of the files is replaced or rewritten.
+func (r *certReloader) fileStamp() string {
+	var stamp string
+	for _, path := range []string{r.cfg.CertPath, r.cfg.KeyPath, r.cfg.ClientCAPath} {
+		if path == "" {
+			continue
+		}
+		if fi, err := os.Stat(path); err == nil {
+			stamp += fmt.Sprintf("%s:%d:%d;", path, fi.ModTime().UnixNano(), fi.Size())
+		}
+	}
+	return stamp
+}
+
+// Start watches for SIGHUP and for file changes until Stop is called.
+func (r *certReloader) Start() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(hup)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-hup:
+				r.reloadAndLog("SIGHUP")
+			case <-ticker.C:
+				if r.fileStamp() != r.stamp {
+					r.reloadAndLog("file change")
+				}
+			}
+		}
+	}()
+}
+
+func (r *certReloader) Stop() {
+	r.once.Do(func() {
+		close(r.stop)
+	})
+}
+
+func (r *certReloader) reloadAndLog(reason string) {
+	log := r.log.With(slog.String("reason", reason))
+
+	if err := r.reload(); err != nil {
+		log.Error("failed to reload tls certificates, keeping the previous ones", sl.Err(err))
+		return
+	}
+	log.Info("tls certificates reloaded")
+}
@@ -0,0 +1,145 @@
+package metricsapp
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/logger/sl"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// domainQueryTimeout bounds the queries run for a single scrape.
+	domainQueryTimeout = 10 * time.Second
+
+	placementWindow = time.Hour
+)
+
+// Storage is where the pool statistics and the domain metrics are read.
+type Storage interface {
+	PoolStat() *pgxpool.Stat
+	StorageOccupancy(ctx context.Context) ([]models.StorageOccupancy, error)
+	VesselCargoCounts(ctx context.Context) ([]models.VesselCargoCount, error)
+	CountPlacementsSince(ctx context.Context, since time.Time) (int64, error)
+}
+
+var (
+	poolAcquiredConns = prometheus.NewDesc("dbcp_db_pool_acquired_conns",
+		"Connections currently acquired from the pool.", nil, nil)
+	poolIdleConns = prometheus.NewDesc("dbcp_db_pool_idle_conns",
+		"Idle connections in the pool.", nil, nil)
+	poolTotalConns = prometheus.NewDesc("dbcp_db_pool_total_conns",
+		"Connections in the pool, including those being opened.", nil, nil)
+	poolMaxConns = prometheus.NewDesc("dbcp_db_pool_max_conns",
+		"Maximum size of the pool.", nil, nil)
+	poolAcquires = prometheus.NewDesc("dbcp_db_pool_acquires_total",
+		"Successful acquires of a connection from the pool.", nil, nil)
+	poolEmptyAcquires = prometheus.NewDesc("dbcp_db_pool_empty_acquires_total",
+		"Acquires that had to wait because the pool was empty.", nil, nil)
+	poolCanceledAcquires = prometheus.NewDesc("dbcp_db_pool_canceled_acquires_total",
+		"Acquires canceled by their context.", nil, nil)
+	poolAcquireWait = prometheus.NewDesc("dbcp_db_pool_acquire_wait_seconds_total",
+		"Time spent waiting for a connection by acquires that found the pool empty.", nil, nil)
+)
+
+// poolCollector reports the pgxpool statistics as of the scrape.
+type poolCollector struct {
+	storage Storage
+}
+
+func newPoolCollector(storage Storage) *poolCollector {
+	return &poolCollector{storage: storage}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		poolAcquiredConns,
+		poolIdleConns,
+		poolTotalConns,
+		poolMaxConns,
+		poolAcquires,
+		poolEmptyAcquires,
+		poolCanceledAcquires,
+		poolAcquireWait,
+	} {
+		ch <- d
+	}
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.storage.PoolStat()
+
+	ch <- prometheus.MustNewConstMetric(poolAcquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(poolIdleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(poolTotalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(poolMaxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquires, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquires, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolCanceledAcquires, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireWait, prometheus.CounterValue, s.EmptyAcquireWaitTime().Seconds())
+}
+
+var (
+	storageLocations = prometheus.NewDesc("dbcp_storage_locations",
+		"Storage locations by cargo type and whether they hold cargo.",
+		[]string{"cargo_type_id", "state"}, nil)
+	vesselCargos = prometheus.NewDesc("dbcp_vessel_cargos",
+		"Cargos of each vessel, archived ones left out.",
+		[]string{"vessel_id"}, nil)
+	recentPlacements = prometheus.NewDesc("dbcp_storage_placements_last_hour",
+		"Cargo placements made in the last hour.", nil, nil)
+)
+
+// domainCollector queries the database on every scrape, so the gauges
+// are as fresh as the scrape. A query that fails is logged and its
+// metrics are left out of that scrape.
+type domainCollector struct {
+	log     *slog.Logger
+	storage Storage
+}
+
+func newDomainCollector(log *slog.Logger, storage Storage) *domainCollector {
+	return &domainCollector{log: log, storage: storage}
+}
+
+func (c *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- storageLocations
+	ch <- vesselCargos
+	ch <- recentPlacements
+}
+
+func (c *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), domainQueryTimeout)
+	defer cancel()
+
+	if items, err := c.storage.StorageOccupancy(ctx); err != nil {
+		c.log.Error("failed to collect storage occupancy", sl.Err(err))
+	} else {
+		for _, it := range items {
+			id := strconv.FormatInt(it.CargoTypeID, 10)
+			ch <- prometheus.MustNewConstMetric(storageLocations, prometheus.GaugeValue,
+				float64(it.Occupied), id, "occupied")
+			ch <- prometheus.MustNewConstMetric(storageLocations, prometheus.GaugeValue,
+				float64(it.Free), id, "free")
+		}
+	}
+
+	if items, err := c.storage.VesselCargoCounts(ctx); err != nil {
+		c.log.Error("failed to collect vessel cargo counts", sl.Err(err))
+	} else {
+		for _, it := range items {
+			ch <- prometheus.MustNewConstMetric(vesselCargos, prometheus.GaugeValue,
+				float64(it.CargoCount), strconv.FormatInt(it.VesselID, 10))
+		}
+	}
+
+	if count, err := c.storage.CountPlacementsSince(ctx, time.Now().Add(-placementWindow)); err != nil {
+		c.log.Error("failed to collect recent placements", sl.Err(err))
+	} else {
+		ch <- prometheus.MustNewConstMetric(recentPlacements, prometheus.GaugeValue, float64(count))
+	}
+}
@@ -10,12 +10,101 @@ import (
 type Config struct {
 	Env 			string 		`yaml:"env" env-default:"local"`
 	GRPC 			GRPCConfig	`yaml:"grpc"`
+	HTTP 			HTTPConfig	`yaml:"http"`
+	Metrics 		MetricsConfig	`yaml:"metrics"`
 	DBConnString 	string		`yaml:"db_conn_string"`
 }
 
 type GRPCConfig struct {
 	Port 	int				`yaml:"port"`
 	Timeout time.Duration	`yaml:"timeout"`
+	// MethodTimeouts overrides Timeout for single methods or whole
+	// services, keyed by "/package.Service/Method" or "/package.Service".
+	MethodTimeouts map[string]time.Duration `yaml:"method_timeouts"`
+	// HealthCheckInterval is how often the database is pinged to decide
+	// the serving status reported by the health service.
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" env-default:"5s"`
+	// Reflection exposes the API schema to tools such as grpcurl.
+	Reflection bool `yaml:"reflection"`
+	Auth AuthConfig `yaml:"auth"`
+	TLS TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig turns on TLS when CertPath and KeyPath are set. With
+// ClientCAPath set clients must present a certificate signed by that CA,
+// unless ClientCertOptional is set. The files are read again on SIGHUP
+// and when they change, checked every ReloadInterval.
+type TLSConfig struct {
+	CertPath string `yaml:"cert_path"`
+	KeyPath string `yaml:"key_path"`
+	ClientCAPath string `yaml:"client_ca_path"`
+	ClientCertOptional bool `yaml:"client_cert_optional"`
+	ReloadInterval time.Duration `yaml:"reload_interval" env-default:"30s"`
+}
+
+// Enabled reports whether the server should listen with TLS.
+func (c TLSConfig) Enabled() bool {
+	return c.CertPath != "" || c.KeyPath != ""
+}
+
+// AuthConfig configures who may call the API. With Enabled unset every
+// caller is let in and may name itself in the x-actor header.
+//
+// Policies map "/package.Service/Method" or "/package.Service" to the
+// lowest role allowed to call it; a method entry wins over its service.
+// Methods without an entry need DefaultRole.
+type AuthConfig struct {
+	Enabled bool `yaml:"enabled"`
+	APIKeys []APIKeyConfig `yaml:"api_keys"`
+	JWT JWTConfig `yaml:"jwt"`
+	DefaultRole string `yaml:"default_role" env-default:"admin"`
+	Policies map[string]PolicyConfig `yaml:"policies"`
+	// ClientCertRoles maps the names of client certificates, their common
+	// name or first DNS name, to roles. Other verified certificates are
+	// viewers.
+	ClientCertRoles map[string]string `yaml:"client_cert_roles"`
+}
+
+// PolicyConfig is the lowest role allowed to call a method. Fields raise
+// it for requests that set the given request fields, e.g. process_cost
+// of CargoTypeService/Update.
+type PolicyConfig struct {
+	Role string `yaml:"role"`
+	Fields map[string]string `yaml:"fields"`
+}
+
+// APIKeyConfig is a static key sent in the x-api-key header. Name is
+// recorded as the actor of the changes made with the key. A key without
+// a role is a viewer.
+type APIKeyConfig struct {
+	Name string `yaml:"name"`
+	Key string `yaml:"key"`
+	Role string `yaml:"role"`
+}
+
+// JWTConfig configures the bearer tokens accepted in the authorization
+// header. Tokens are signed either with Secret (HMAC) or with the private
+// half of the PEM key at PublicKeyPath (RSA, ECDSA or Ed25519); with
+// neither set tokens are not accepted. Issuer and Audience are checked
+// when set. The caller gets the highest role listed in the "roles"
+// claim, or viewer.
+type JWTConfig struct {
+	Secret string `yaml:"secret" env:"JWT_SECRET"`
+	PublicKeyPath string `yaml:"public_key_path"`
+	Issuer string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+}
+
+// HTTPConfig configures the HTTP/JSON gateway to the gRPC API. The
+// gateway is off when Port is zero.
+type HTTPConfig struct {
+	Port int `yaml:"port"`
+}
+
+// MetricsConfig configures the Prometheus endpoint, served on /metrics.
+// It is off when Port is zero.
+type MetricsConfig struct {
+	Port int `yaml:"port"`
 }
 
 func MustLoad() *Config {
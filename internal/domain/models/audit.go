@@ -0,0 +1,56 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditEntity string
+
+const (
+	AuditVessel          AuditEntity = "vessel"
+	AuditCargoType       AuditEntity = "cargo_type"
+	AuditCargo           AuditEntity = "cargo"
+	AuditOperation       AuditEntity = "operation"
+	AuditOperationCargo  AuditEntity = "operation_cargo"
+	AuditStorageLoc      AuditEntity = "storage_loc"
+	AuditPortCall        AuditEntity = "port_call"
+	AuditBerth           AuditEntity = "berth"
+	AuditBerthAllocation AuditEntity = "berth_allocation"
+)
+
+type AuditAction string
+
+const (
+	AuditCreate     AuditAction = "CREATE"
+	AuditUpdate     AuditAction = "UPDATE"
+	AuditDelete     AuditAction = "DELETE"
+	AuditRestore    AuditAction = "RESTORE"
+	AuditUse        AuditAction = "USE"
+	AuditReset      AuditAction = "RESET"
+	AuditMove       AuditAction = "MOVE"
+	AuditAutoPlace  AuditAction = "AUTO_PLACE"
+	AuditTransition AuditAction = "TRANSITION"
+)
+
+type AuditRecord struct {
+	ID			int64
+	EntityType	AuditEntity
+	EntityID	string
+	Action		AuditAction
+	Before		json.RawMessage
+	After		json.RawMessage
+	Actor		string
+	// ClaimedActor is the unverified name sent by an unauthenticated
+	// caller; Actor is anonymous then.
+	ClaimedActor	*string
+	At			time.Time
+}
+
+type AuditFilter struct {
+	EntityType	*AuditEntity
+	EntityID	*string
+	Actor		*string
+	From		*time.Time
+	To			*time.Time
+}
\ No newline at end of file
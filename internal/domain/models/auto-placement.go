@@ -0,0 +1,20 @@
+package models
+
+type PlacementAssignment struct {
+	CargoID			int64
+	StorageLocID	int64
+}
+
+type UnplacedCargo struct {
+	CargoID		int64
+	Reason		error
+}
+
+type AutoPlacementResult struct {
+	Placed		[]PlacementAssignment
+	Unplaced	[]UnplacedCargo
+}
+
+// PlacementPlanner decides where each cargo goes given the storage
+// locations available to it.
+type PlacementPlanner func(cargos []Cargo, locs []StorageLocation) AutoPlacementResult
\ No newline at end of file
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+type BerthAllocation struct {
+	ID         int64
+	BerthID    int64
+	VesselID   int64
+	PortCallID *int64
+	StartsAt   time.Time
+	EndsAt     time.Time
+}
+
+type BerthAllocationFilter struct {
+	BerthID  *int64
+	VesselID *int64
+	From     *time.Time
+	To       *time.Time
+}
@@ -0,0 +1,9 @@
+package models
+
+type Berth struct {
+	ID                 int64
+	Title              string
+	Length             float64
+	MaxDraft           float64
+	AllowedVesselTypes []string
+}
@@ -0,0 +1,26 @@
+package models
+
+type CargoSortField int
+
+const (
+	CargoSortByID CargoSortField = iota
+	CargoSortByTitle
+	CargoSortByWeight
+	CargoSortByVolume
+)
+
+type CargoFilter struct {
+	TypeID          *int64
+	VesselID        *int64
+	PortCallID      *int64
+	MinWeight       *float64
+	MaxWeight       *float64
+	MinVolume       *float64
+	MaxVolume       *float64
+	TitleContains   *string
+	Placed          *bool
+	Status          *CargoStatus
+	IncludeArchived bool
+	SortBy          CargoSortField
+	Descending      bool
+}
@@ -1,7 +1,11 @@
 package models
 
+import "time"
+
 type CargoType struct {
 	ID 			int64
 	Title 		string
 	ProcessCost	float64
+	Version		int64
+	DeletedAt	*time.Time
 }
\ No newline at end of file
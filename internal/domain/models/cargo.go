@@ -1,5 +1,19 @@
 package models
 
+import "time"
+
+type CargoStatus string
+
+const (
+	CargoExpected  CargoStatus = "EXPECTED"
+	CargoOnVessel  CargoStatus = "ON_VESSEL"
+	CargoUnloaded  CargoStatus = "UNLOADED"
+	CargoInStorage CargoStatus = "IN_STORAGE"
+	CargoReleased  CargoStatus = "RELEASED"
+	CargoLoaded    CargoStatus = "LOADED"
+	CargoDeparted  CargoStatus = "DEPARTED"
+)
+
 type Cargo struct {
 	ID 			int64
 	Title 		string
@@ -7,4 +21,8 @@ type Cargo struct {
 	Weight 		float64
 	Volume		float64
 	VesselID	int64
+	PortCallID	*int64
+	Status		CargoStatus
+	Version		int64
+	DeletedAt	*time.Time
 }
\ No newline at end of file
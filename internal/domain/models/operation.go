@@ -2,8 +2,25 @@ package models
 
 import "time"
 
+type OperationKind string
+
+const (
+	OperationUnloading        OperationKind = "UNLOADING"
+	OperationLoading          OperationKind = "LOADING"
+	OperationPlacement        OperationKind = "PLACEMENT"
+	OperationRelease          OperationKind = "RELEASE"
+	OperationTransfer         OperationKind = "TRANSFER"
+	OperationInspection       OperationKind = "INSPECTION"
+	OperationArrival          OperationKind = "ARRIVAL"
+	OperationDeparture        OperationKind = "DEPARTURE"
+	OperationCustomsClearance OperationKind = "CUSTOMS_CLEARANCE"
+	OperationOther            OperationKind = "OTHER"
+)
+
 type Operation struct {
 	ID 			int64
 	Title 		string
+	Kind		OperationKind
 	CreatedAt	time.Time
-}
\ No newline at end of file
+	Version		int64
+}
@@ -0,0 +1,13 @@
+package models
+
+type PageRequest struct {
+	Size      int32
+	Token     string
+	WithTotal bool
+}
+
+type Page[T any] struct {
+	Items         []T
+	NextPageToken string
+	TotalCount    *int64
+}
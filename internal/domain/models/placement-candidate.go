@@ -0,0 +1,16 @@
+package models
+
+type PlacementStrategy int
+
+const (
+	PlacementBestFit PlacementStrategy = iota
+	PlacementWorstFit
+	PlacementFirstFit
+)
+
+type PlacementCandidate struct {
+	StorageLocation		StorageLocation
+	RemainingWeight		float64
+	RemainingVolume		float64
+	Score				float64
+}
\ No newline at end of file
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type PortCallStatus string
+
+const (
+	PortCallScheduled PortCallStatus = "SCHEDULED"
+	PortCallArrived   PortCallStatus = "ARRIVED"
+	PortCallDeparted  PortCallStatus = "DEPARTED"
+	PortCallCancelled PortCallStatus = "CANCELLED"
+)
+
+type PortCall struct {
+	ID           int64
+	VesselID     int64
+	VoyageNumber string
+	Status       PortCallStatus
+	ETA          time.Time
+	ETD          time.Time
+	ATA          *time.Time
+	ATD          *time.Time
+}
@@ -1,12 +1,12 @@
 package models
 
-import "time"
-
 type StorageLocation struct {
 	ID 				int64
 	CargoTypeID 	int64
 	MaxWeight		float64
 	MaxVolume		float64
-	CargoID			*int64
-	DateOfPlacement	*time.Time
+	UsedWeight		float64
+	UsedVolume		float64
+	CargoCount		int64
+	Version			int64
 }
\ No newline at end of file
@@ -0,0 +1,9 @@
+package models
+
+// StorageOccupancy counts the storage locations of a cargo type that hold
+// cargo and those that are empty.
+type StorageOccupancy struct {
+	CargoTypeID int64
+	Occupied    int64
+	Free        int64
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type StoragePlacement struct {
+	ID				int64
+	StorageLocID	int64
+	CargoID			int64
+	PlacedAt		time.Time
+	ReleasedAt		*time.Time
+}
\ No newline at end of file
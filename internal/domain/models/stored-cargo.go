@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type StoredCargo struct {
+	PlacementID		int64
+	CargoID			int64
+	Title			string
+	TypeID			int64
+	Weight			float64
+	Volume			float64
+	PlacedAt		time.Time
+}
\ No newline at end of file
@@ -0,0 +1,6 @@
+package models
+
+type VesselCargoCount struct {
+	VesselID   int64
+	CargoCount int64
+}
@@ -0,0 +1,9 @@
+package models
+
+type VesselLoad struct {
+	VesselID           int64
+	MaxLoad            float64
+	CurrentLoad        float64
+	RemainingCapacity  float64
+	UtilisationPercent float64
+}
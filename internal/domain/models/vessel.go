@@ -1,8 +1,12 @@
 package models
 
+import "time"
+
 type Vessel struct {
 	ID			int64
 	Title		string
 	VesselType	string
 	MaxLoad		float64
+	Version		int64
+	DeletedAt	*time.Time
 }
\ No newline at end of file
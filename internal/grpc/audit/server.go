@@ -0,0 +1,145 @@
+package audit
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
+	auditv1 "dbcp/protos/gen/go/audit"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Audit interface {
+	Query(
+		ctx context.Context,
+		filter models.AuditFilter,
+		page models.PageRequest,
+	) (models.Page[models.AuditRecord], error)
+}
+
+type serverAPI struct {
+	auditv1.UnimplementedAuditServiceServer
+	audit Audit
+}
+
+func Register(gRPCServer *grpc.Server, audit Audit) {
+	auditv1.RegisterAuditServiceServer(
+		gRPCServer,
+		&serverAPI{
+			audit: audit,
+		},
+	)
+}
+
+var entityTypes = map[auditv1.EntityType]models.AuditEntity{
+	auditv1.EntityType_ENTITY_TYPE_VESSEL:           models.AuditVessel,
+	auditv1.EntityType_ENTITY_TYPE_CARGO_TYPE:       models.AuditCargoType,
+	auditv1.EntityType_ENTITY_TYPE_CARGO:            models.AuditCargo,
+	auditv1.EntityType_ENTITY_TYPE_OPERATION:        models.AuditOperation,
+	auditv1.EntityType_ENTITY_TYPE_OPERATION_CARGO:  models.AuditOperationCargo,
+	auditv1.EntityType_ENTITY_TYPE_STORAGE_LOCATION: models.AuditStorageLoc,
+	auditv1.EntityType_ENTITY_TYPE_PORT_CALL:        models.AuditPortCall,
+	auditv1.EntityType_ENTITY_TYPE_BERTH:            models.AuditBerth,
+	auditv1.EntityType_ENTITY_TYPE_BERTH_ALLOCATION: models.AuditBerthAllocation,
+}
+
+var protoEntityTypes = map[models.AuditEntity]auditv1.EntityType{
+	models.AuditVessel:          auditv1.EntityType_ENTITY_TYPE_VESSEL,
+	models.AuditCargoType:       auditv1.EntityType_ENTITY_TYPE_CARGO_TYPE,
+	models.AuditCargo:           auditv1.EntityType_ENTITY_TYPE_CARGO,
+	models.AuditOperation:       auditv1.EntityType_ENTITY_TYPE_OPERATION,
+	models.AuditOperationCargo:  auditv1.EntityType_ENTITY_TYPE_OPERATION_CARGO,
+	models.AuditStorageLoc:      auditv1.EntityType_ENTITY_TYPE_STORAGE_LOCATION,
+	models.AuditPortCall:        auditv1.EntityType_ENTITY_TYPE_PORT_CALL,
+	models.AuditBerth:           auditv1.EntityType_ENTITY_TYPE_BERTH,
+	models.AuditBerthAllocation: auditv1.EntityType_ENTITY_TYPE_BERTH_ALLOCATION,
+}
+
+var protoActions = map[models.AuditAction]auditv1.Action{
+	models.AuditCreate:     auditv1.Action_ACTION_CREATE,
+	models.AuditUpdate:     auditv1.Action_ACTION_UPDATE,
+	models.AuditDelete:     auditv1.Action_ACTION_DELETE,
+	models.AuditUse:        auditv1.Action_ACTION_USE,
+	models.AuditReset:      auditv1.Action_ACTION_RESET,
+	models.AuditMove:       auditv1.Action_ACTION_MOVE,
+	models.AuditAutoPlace:  auditv1.Action_ACTION_AUTO_PLACE,
+	models.AuditTransition: auditv1.Action_ACTION_TRANSITION,
+	models.AuditRestore:    auditv1.Action_ACTION_RESTORE,
+}
+
+func (s *serverAPI) Query(
+	ctx context.Context,
+	req *auditv1.QueryRequest,
+) (*auditv1.QueryResponse, error) {
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	filter := models.AuditFilter{
+		EntityID: req.EntityId,
+		Actor:    req.Actor,
+	}
+	if req.GetEntityType() != auditv1.EntityType_ENTITY_TYPE_UNSPECIFIED {
+		entity, ok := entityTypes[req.GetEntityType()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown entity type")
+		}
+		filter.EntityType = &entity
+	}
+	if filter.EntityID != nil && filter.EntityType == nil {
+		return nil, status.Error(codes.InvalidArgument, "entity_type is required with entity_id")
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, status.Error(codes.InvalidArgument, "to must not be before from")
+	}
+
+	page, err := s.audit.Query(ctx, filter, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "failed to query audit log")
+	}
+
+	resp := make([]*auditv1.AuditRecord, 0, len(page.Items))
+	for _, r := range page.Items {
+		resp = append(resp, toProtoRecord(r))
+	}
+
+	return &auditv1.QueryResponse{
+		Records:       resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func toProtoRecord(r models.AuditRecord) *auditv1.AuditRecord {
+	return &auditv1.AuditRecord{
+		Id:           r.ID,
+		EntityType:   protoEntityTypes[r.EntityType],
+		EntityId:     r.EntityID,
+		Action:       protoActions[r.Action],
+		Before:       string(r.Before),
+		After:        string(r.After),
+		Actor:        r.Actor,
+		ClaimedActor: r.ClaimedActor,
+		At:           timestamppb.New(r.At),
+	}
+}
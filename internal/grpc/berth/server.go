@@ -0,0 +1,211 @@
+package berth
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
+	"dbcp/internal/storage"
+	berthv1 "dbcp/protos/gen/go/berth"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Berth interface {
+	List(ctx context.Context, page models.PageRequest) (models.Page[models.Berth], error)
+	Get(ctx context.Context, id int64) (models.Berth, error)
+	Create(ctx context.Context, berth models.Berth) (int64, error)
+	Update(
+		ctx context.Context,
+		id int64,
+		title *string,
+		length *float64,
+		maxDraft *float64,
+		allowedVesselTypes *[]string,
+	) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type serverAPI struct {
+	berthv1.UnimplementedBerthServiceServer
+	berth Berth
+}
+
+func Register(gRPCServer *grpc.Server, berth Berth) {
+	berthv1.RegisterBerthServiceServer(gRPCServer, &serverAPI{berth: berth})
+}
+
+func (s *serverAPI) List(
+	ctx context.Context,
+	req *berthv1.ListRequest,
+) (*berthv1.ListResponse, error) {
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.berth.List(ctx, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "failed to list berths")
+	}
+
+	resp := make([]*berthv1.Berth, 0, len(page.Items))
+	for _, b := range page.Items {
+		resp = append(resp, toProtoBerth(b))
+	}
+
+	return &berthv1.ListResponse{
+		Berths:        resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *serverAPI) Get(
+	ctx context.Context,
+	req *berthv1.GetRequest,
+) (*berthv1.GetResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	b, err := s.berth.Get(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, storage.ErrBerthNotFound) {
+			return nil, status.Error(codes.NotFound, "berth not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get berth")
+	}
+
+	return &berthv1.GetResponse{Berth: toProtoBerth(b)}, nil
+}
+
+func (s *serverAPI) Create(
+	ctx context.Context,
+	req *berthv1.CreateRequest,
+) (*berthv1.CreateResponse, error) {
+
+	if req.GetTitle() == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.GetLength() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "length must be positive")
+	}
+	if req.GetMaxDraft() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_draft must be positive")
+	}
+
+	id, err := s.berth.Create(ctx, models.Berth{
+		Title:              req.GetTitle(),
+		Length:             req.GetLength(),
+		MaxDraft:           req.GetMaxDraft(),
+		AllowedVesselTypes: req.GetAllowedVesselTypes(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBerthExists):
+			return nil, status.Error(codes.AlreadyExists, "berth already exists")
+		default:
+			return nil, status.Error(codes.Internal, "failed to create berth")
+		}
+	}
+
+	return &berthv1.CreateResponse{Id: id}, nil
+}
+
+func (s *serverAPI) Update(
+	ctx context.Context,
+	req *berthv1.UpdateRequest,
+) (*berthv1.UpdateResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	var title *string
+	if req.GetTitle() != "" {
+		t := req.GetTitle()
+		title = &t
+	}
+
+	var length *float64
+	if req.GetLength() > 0 {
+		l := req.GetLength()
+		length = &l
+	}
+
+	var maxDraft *float64
+	if req.GetMaxDraft() > 0 {
+		d := req.GetMaxDraft()
+		maxDraft = &d
+	}
+
+	var allowedVesselTypes *[]string
+	if req.GetAllowedVesselTypes() != nil {
+		vt := req.GetAllowedVesselTypes().GetValues()
+		allowedVesselTypes = &vt
+	}
+
+	if err := s.berth.Update(
+		ctx,
+		req.GetId(),
+		title,
+		length,
+		maxDraft,
+		allowedVesselTypes,
+	); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBerthNotFound):
+			return nil, status.Error(codes.NotFound, "berth not found")
+		case errors.Is(err, storage.ErrBerthExists):
+			return nil, status.Error(codes.AlreadyExists, "berth already exists")
+		default:
+			return nil, status.Error(codes.Internal, "failed to update berth")
+		}
+	}
+
+	return &berthv1.UpdateResponse{}, nil
+}
+
+func (s *serverAPI) Delete(
+	ctx context.Context,
+	req *berthv1.DeleteRequest,
+) (*berthv1.DeleteResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.berth.Delete(ctx, req.GetId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBerthInUse):
+			return nil, status.Error(codes.FailedPrecondition, "berth is used")
+		case errors.Is(err, storage.ErrBerthNotFound):
+			return nil, status.Error(codes.NotFound, "berth not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to delete berth")
+		}
+	}
+
+	return &berthv1.DeleteResponse{}, nil
+}
+
+func toProtoBerth(b models.Berth) *berthv1.Berth {
+	return &berthv1.Berth{
+		Id:                 b.ID,
+		Title:              b.Title,
+		Length:             b.Length,
+		MaxDraft:           b.MaxDraft,
+		AllowedVesselTypes: b.AllowedVesselTypes,
+	}
+}
@@ -0,0 +1,203 @@
+package berthschedule
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
+	"dbcp/internal/storage"
+	berthschedulev1 "dbcp/protos/gen/go/berthschedule"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type BerthSchedule interface {
+	List(
+		ctx context.Context,
+		filter models.BerthAllocationFilter,
+		page models.PageRequest,
+	) (models.Page[models.BerthAllocation], error)
+	Get(ctx context.Context, id int64) (models.BerthAllocation, error)
+	Allocate(ctx context.Context, allocation models.BerthAllocation) (int64, error)
+	Cancel(ctx context.Context, id int64) error
+}
+
+type serverAPI struct {
+	berthschedulev1.UnimplementedBerthScheduleServiceServer
+	schedule BerthSchedule
+}
+
+func Register(gRPCServer *grpc.Server, schedule BerthSchedule) {
+	berthschedulev1.RegisterBerthScheduleServiceServer(
+		gRPCServer,
+		&serverAPI{
+			schedule: schedule,
+		},
+	)
+}
+
+func (s *serverAPI) List(
+	ctx context.Context,
+	req *berthschedulev1.ListRequest,
+) (*berthschedulev1.ListResponse, error) {
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	filter := models.BerthAllocationFilter{
+		BerthID:  req.BerthId,
+		VesselID: req.VesselId,
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, status.Error(codes.InvalidArgument, "to must not be before from")
+	}
+
+	page, err := s.schedule.List(ctx, filter, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "failed to list berth allocations")
+	}
+
+	resp := make([]*berthschedulev1.Allocation, 0, len(page.Items))
+	for _, a := range page.Items {
+		resp = append(resp, toProtoAllocation(a))
+	}
+
+	return &berthschedulev1.ListResponse{
+		Allocations:   resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *serverAPI) Get(
+	ctx context.Context,
+	req *berthschedulev1.GetRequest,
+) (*berthschedulev1.GetResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	a, err := s.schedule.Get(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, storage.ErrBerthAllocationNotFound) {
+			return nil, status.Error(codes.NotFound, "berth allocation not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get berth allocation")
+	}
+
+	return &berthschedulev1.GetResponse{Allocation: toProtoAllocation(a)}, nil
+}
+
+func (s *serverAPI) Allocate(
+	ctx context.Context,
+	req *berthschedulev1.AllocateRequest,
+) (*berthschedulev1.AllocateResponse, error) {
+
+	if req.GetBerthId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "berth_id is required")
+	}
+	if req.PortCallId != nil && req.GetPortCallId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "port_call_id must be positive")
+	}
+	if req.PortCallId == nil {
+		if req.GetVesselId() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "vessel_id is required")
+		}
+		if req.GetStartsAt() == nil || req.GetEndsAt() == nil {
+			return nil, status.Error(codes.InvalidArgument, "starts_at and ends_at are required")
+		}
+	}
+
+	allocation := models.BerthAllocation{
+		BerthID:    req.GetBerthId(),
+		VesselID:   req.GetVesselId(),
+		PortCallID: req.PortCallId,
+	}
+	if req.GetStartsAt() != nil {
+		allocation.StartsAt = req.GetStartsAt().AsTime()
+	}
+	if req.GetEndsAt() != nil {
+		allocation.EndsAt = req.GetEndsAt().AsTime()
+	}
+	if !allocation.StartsAt.IsZero() && !allocation.EndsAt.IsZero() &&
+		!allocation.EndsAt.After(allocation.StartsAt) {
+		return nil, status.Error(codes.InvalidArgument, "ends_at must be after starts_at")
+	}
+
+	id, err := s.schedule.Allocate(ctx, allocation)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBerthNotFound):
+			return nil, status.Error(codes.NotFound, "berth not found")
+		case errors.Is(err, storage.ErrVesselNotFound):
+			return nil, status.Error(codes.NotFound, "vessel not found")
+		case errors.Is(err, storage.ErrPortCallNotFound):
+			return nil, status.Error(codes.NotFound, "port call not found")
+		case errors.Is(err, storage.ErrRelatedEntityNotFound):
+			return nil, status.Error(codes.FailedPrecondition, "port call does not belong to the vessel")
+		case errors.Is(err, storage.ErrBerthVesselTypeNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, "vessel type is not allowed at the berth")
+		case errors.Is(err, storage.ErrBerthAllocationConflict):
+			return nil, status.Error(codes.FailedPrecondition, "berth is already allocated for this period")
+		case errors.Is(err, storage.ErrVesselAllocationConflict):
+			return nil, status.Error(codes.FailedPrecondition, "vessel is already allocated to a berth for this period")
+		default:
+			return nil, status.Error(codes.Internal, "failed to allocate berth")
+		}
+	}
+
+	return &berthschedulev1.AllocateResponse{Id: id}, nil
+}
+
+func (s *serverAPI) Cancel(
+	ctx context.Context,
+	req *berthschedulev1.CancelRequest,
+) (*berthschedulev1.CancelResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.schedule.Cancel(ctx, req.GetId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrBerthAllocationNotFound):
+			return nil, status.Error(codes.NotFound, "berth allocation not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to cancel berth allocation")
+		}
+	}
+
+	return &berthschedulev1.CancelResponse{}, nil
+}
+
+func toProtoAllocation(a models.BerthAllocation) *berthschedulev1.Allocation {
+	return &berthschedulev1.Allocation{
+		Id:         a.ID,
+		BerthId:    a.BerthID,
+		VesselId:   a.VesselID,
+		PortCallId: a.PortCallID,
+		StartsAt:   timestamppb.New(a.StartsAt),
+		EndsAt:     timestamppb.New(a.EndsAt),
+	}
+}
+
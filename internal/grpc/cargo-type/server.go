@@ -3,6 +3,7 @@ package cargotype
 import (
 	"context"
 	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	cargotypev1 "dbcp/protos/gen/go/cargotype"
 	"errors"
@@ -10,18 +11,25 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CargoType interface {
-	List(ctx context.Context) ([]models.CargoType, error)
-	Get(ctx context.Context, id int64) (models.CargoType, error)
+	List(
+		ctx context.Context,
+		page models.PageRequest,
+		includeArchived bool,
+	) (models.Page[models.CargoType], error)
+	Get(ctx context.Context, id int64, includeArchived bool) (models.CargoType, error)
 	Create(ctx context.Context, vessel models.CargoType) (int64, error)
 	Delete(ctx context.Context, id int64) (error)
+	Restore(ctx context.Context, id int64) (error)
 	Update(
 		ctx context.Context, 
 		id int64,
 		title *string, 
 		processCost *float64,
+		expectedVersion *int64,
 	) (error)
 }
 
@@ -38,28 +46,39 @@ func (s *serverAPI) List(
 	ctx context.Context,
 	req *cargotypev1.ListRequest,
 ) (*cargotypev1.ListResponse, error) {
-	ctList, err := s.cargoType.List(ctx)
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.cargoType.List(ctx, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	}, req.GetIncludeArchived())
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, "failed to list cargo types")
 	}
 
 	var pbList []*cargotypev1.CargoType
-	for _, ct := range ctList {
-		pbList = append(pbList, &cargotypev1.CargoType{
-			Id:          ct.ID,
-			Title:       ct.Title,
-			ProcessCost: ct.ProcessCost,
-		})
+	for _, ct := range page.Items {
+		pbList = append(pbList, toProtoCargoType(ct))
 	}
 
-	return &cargotypev1.ListResponse{CargoTypes: pbList}, nil
+	return &cargotypev1.ListResponse{
+		CargoTypes:    pbList,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
 }
 
 func (s *serverAPI) Get(
 	ctx context.Context,
 	req *cargotypev1.GetRequest,
 ) (*cargotypev1.GetResponse, error) {
-	ct, err := s.cargoType.Get(ctx, req.GetId())
+	ct, err := s.cargoType.Get(ctx, req.GetId(), req.GetIncludeArchived())
 	if err != nil {
 		switch {
 		case errors.Is(err, storage.ErrCargoTypeNotFound):
@@ -69,13 +88,7 @@ func (s *serverAPI) Get(
 		}
 	}
 
-	return &cargotypev1.GetResponse{
-		CargoType: &cargotypev1.CargoType{
-			Id:          ct.ID,
-			Title:       ct.Title,
-			ProcessCost: ct.ProcessCost,
-		},
-	}, nil
+	return &cargotypev1.GetResponse{CargoType: toProtoCargoType(ct)}, nil
 }
 
 func (s *serverAPI) Create(
@@ -109,6 +122,10 @@ func (s *serverAPI) Update(
 	ctx context.Context,
 	req *cargotypev1.UpdateRequest,
 ) (*cargotypev1.UpdateResponse, error) {
+	if req.ExpectedVersion != nil && req.GetExpectedVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version must be positive")
+	}
+
 	var title *string
 	if req.GetTitle() != "" {
 		t := req.GetTitle()
@@ -121,13 +138,15 @@ func (s *serverAPI) Update(
 		processCost = &pc
 	}
 
-	err := s.cargoType.Update(ctx, req.GetId(), title, processCost)
+	err := s.cargoType.Update(ctx, req.GetId(), title, processCost, req.ExpectedVersion)
 	if err != nil {
 		switch {
 		case errors.Is(err, storage.ErrCargoTypeNotFound):
 			return nil, status.Error(codes.NotFound, "cargo type not found")
 		case errors.Is(err, storage.ErrCargoTypeExists):
 			return nil, status.Error(codes.AlreadyExists, "cargo type already exists")
+		case errors.Is(err, storage.ErrConcurrentModification):
+			return nil, status.Error(codes.Aborted, "cargo type was modified concurrently")
 		default:
 			return nil, status.Error(codes.Internal, "failed to update cargp type")
 		}
@@ -143,8 +162,6 @@ func (s *serverAPI) Delete(
 	err := s.cargoType.Delete(ctx, req.GetId())
 	if err != nil {
 		switch {
-		case errors.Is(err, storage.ErrCargoTypeInUse):
-			return nil, status.Error(codes.FailedPrecondition, "cargo type is used")
 		case errors.Is(err, storage.ErrCargoTypeNotFound):
 			return nil, status.Error(codes.NotFound, "cargo type not found")
 		default:
@@ -153,4 +170,40 @@ func (s *serverAPI) Delete(
 	}
 
 	return &cargotypev1.DeleteResponse{}, nil
+}
+
+func (s *serverAPI) Restore(
+	ctx context.Context,
+	req *cargotypev1.RestoreRequest,
+) (*cargotypev1.RestoreResponse, error) {
+	err := s.cargoType.Restore(ctx, req.GetId())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCargoTypeNotFound):
+			return nil, status.Error(codes.NotFound, "cargo type not found")
+		case errors.Is(err, storage.ErrCargoTypeNotArchived):
+			return nil, status.Error(codes.FailedPrecondition, "cargo type is not archived")
+		case errors.Is(err, storage.ErrCargoTypeExists):
+			return nil, status.Error(codes.AlreadyExists, "cargo type already exists")
+		default:
+			return nil, status.Error(codes.Internal, "failed to restore cargo type")
+		}
+	}
+
+	return &cargotypev1.RestoreResponse{}, nil
+}
+
+func toProtoCargoType(ct models.CargoType) *cargotypev1.CargoType {
+	var archivedAt *timestamppb.Timestamp
+	if ct.DeletedAt != nil {
+		archivedAt = timestamppb.New(*ct.DeletedAt)
+	}
+
+	return &cargotypev1.CargoType{
+		Id:          ct.ID,
+		Title:       ct.Title,
+		ProcessCost: ct.ProcessCost,
+		Version:     ct.Version,
+		ArchivedAt:  archivedAt,
+	}
 }
\ No newline at end of file
@@ -3,20 +3,33 @@ package cargo
 import (
 	"context"
 	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	cargov1 "dbcp/protos/gen/go/cargo"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Cargo interface {
-	List(ctx context.Context) ([]models.Cargo, error)
-	Get(ctx context.Context, id int64) (models.Cargo, error)
+	List(
+		ctx context.Context,
+		page models.PageRequest,
+		includeArchived bool,
+	) (models.Page[models.Cargo], error)
+	Search(
+		ctx context.Context,
+		filter models.CargoFilter,
+		page models.PageRequest,
+	) (models.Page[models.Cargo], error)
+	Get(ctx context.Context, id int64, includeArchived bool) (models.Cargo, error)
 	Create(ctx context.Context, cargo models.Cargo) (int64, error)
 	Delete(ctx context.Context, id int64) (error)
+	Restore(ctx context.Context, id int64) (error)
 	Update(
 		ctx context.Context, 
 		id int64,
@@ -25,7 +38,20 @@ type Cargo interface {
 		weight *float64,
 		volume *float64,
 		vesselID *int64,
+		portCallID *int64,
+		expectedVersion *int64,
 	) (error)
+	Transition(
+		ctx context.Context,
+		id int64,
+		to models.CargoStatus,
+		at time.Time,
+	) error
+	PlacementHistory(
+		ctx context.Context,
+		id int64,
+		page models.PageRequest,
+	) (models.Page[models.StoragePlacement], error)
 }
 
 type serverAPI struct {
@@ -39,20 +65,117 @@ func Register(gRPCServer *grpc.Server, cargo Cargo) {
 
 func (s *serverAPI) List(
 	ctx context.Context,
-	_ *cargov1.ListRequest,
+	req *cargov1.ListRequest,
 ) (*cargov1.ListResponse, error) {
 
-	cargos, err := s.cargo.List(ctx)
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.cargo.List(ctx, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	}, req.GetIncludeArchived())
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, "failed to list cargos")
 	}
 
-	resp := make([]*cargov1.Cargo, 0, len(cargos))
-	for _, c := range cargos {
+	resp := make([]*cargov1.Cargo, 0, len(page.Items))
+	for _, c := range page.Items {
+		resp = append(resp, toProtoCargo(c))
+	}
+
+	return &cargov1.ListResponse{
+		Cargos:        resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *serverAPI) Search(
+	ctx context.Context,
+	req *cargov1.SearchRequest,
+) (*cargov1.SearchResponse, error) {
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+	if req.MinWeight != nil && req.MaxWeight != nil && req.GetMinWeight() > req.GetMaxWeight() {
+		return nil, status.Error(codes.InvalidArgument, "min_weight must not exceed max_weight")
+	}
+	if req.MinVolume != nil && req.MaxVolume != nil && req.GetMinVolume() > req.GetMaxVolume() {
+		return nil, status.Error(codes.InvalidArgument, "min_volume must not exceed max_volume")
+	}
+
+	var sortBy models.CargoSortField
+	switch req.GetSortBy() {
+	case cargov1.CargoSortField_CARGO_SORT_FIELD_UNSPECIFIED,
+		cargov1.CargoSortField_CARGO_SORT_FIELD_ID:
+		sortBy = models.CargoSortByID
+	case cargov1.CargoSortField_CARGO_SORT_FIELD_TITLE:
+		sortBy = models.CargoSortByTitle
+	case cargov1.CargoSortField_CARGO_SORT_FIELD_WEIGHT:
+		sortBy = models.CargoSortByWeight
+	case cargov1.CargoSortField_CARGO_SORT_FIELD_VOLUME:
+		sortBy = models.CargoSortByVolume
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown sort_by")
+	}
+
+	var cargoStatus *models.CargoStatus
+	if req.Status != nil {
+		st, ok := cargoStatuses[req.GetStatus()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown status")
+		}
+		cargoStatus = &st
+	}
+
+	filter := models.CargoFilter{
+		TypeID:        req.TypeId,
+		VesselID:      req.VesselId,
+		MinWeight:     req.MinWeight,
+		MaxWeight:     req.MaxWeight,
+		MinVolume:     req.MinVolume,
+		MaxVolume:     req.MaxVolume,
+		TitleContains: req.TitleContains,
+		PortCallID:    req.PortCallId,
+		Placed:        req.Placed,
+		Status:          cargoStatus,
+		IncludeArchived: req.GetIncludeArchived(),
+		SortBy:          sortBy,
+		Descending:      req.GetDescending(),
+	}
+
+	page, err := s.cargo.Search(ctx, filter, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		if errors.Is(err, storage.ErrInvalidFilter) {
+			return nil, status.Error(codes.InvalidArgument, "invalid filter")
+		}
+		return nil, status.Error(codes.Internal, "failed to search cargos")
+	}
+
+	resp := make([]*cargov1.Cargo, 0, len(page.Items))
+	for _, c := range page.Items {
 		resp = append(resp, toProtoCargo(c))
 	}
 
-	return &cargov1.ListResponse{Cargos: resp}, nil
+	return &cargov1.SearchResponse{
+		Cargos:        resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
 }
 
 func (s *serverAPI) Get(
@@ -64,7 +187,7 @@ func (s *serverAPI) Get(
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	cargo, err := s.cargo.Get(ctx, req.GetId())
+	cargo, err := s.cargo.Get(ctx, req.GetId(), req.GetIncludeArchived())
 	if err != nil {
 		if errors.Is(err, storage.ErrCargoNotFound) {
 			return nil, status.Error(codes.NotFound, "cargo not found")
@@ -95,6 +218,9 @@ func (s *serverAPI) Create(
 	if req.GetVesselId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "vessel_id is required")
 	}
+	if req.PortCallId != nil && req.GetPortCallId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "port_call_id must be positive")
+	}
 
 
 	cargo := models.Cargo{
@@ -103,6 +229,7 @@ func (s *serverAPI) Create(
 		Weight:     req.GetWeight(),
 		Volume:		req.GetVolume(),
 		VesselID:	req.GetVesselId(),
+		PortCallID:	req.PortCallId,
 	}
 
 	id, err := s.cargo.Create(ctx, cargo)
@@ -112,6 +239,8 @@ func (s *serverAPI) Create(
 			return nil, status.Error(codes.AlreadyExists, "cargo already exists")
 		case errors.Is(err, storage.ErrRelatedEntityNotFound):
 			return nil, status.Error(codes.FailedPrecondition, "one or more related entities not found")
+		case errors.Is(err, storage.ErrVesselOverloaded):
+			return nil, status.Error(codes.FailedPrecondition, "vessel max load exceeded")
 		default:
 			return nil, status.Error(codes.Internal, "failed to create cargo")
 		}
@@ -135,6 +264,7 @@ func (s *serverAPI) Update(
 		weight      *float64
 		volume      *float64
 		vesselID    *int64
+		portCallID  *int64
 	)
 
 	if req.GetTitle() != "" {
@@ -157,6 +287,14 @@ func (s *serverAPI) Update(
 		vid := req.GetVesselId()
 		vesselID = &vid
 	}
+	if req.GetPortCallId() > 0 {
+		pcid := req.GetPortCallId()
+		portCallID = &pcid
+	}
+
+	if req.ExpectedVersion != nil && req.GetExpectedVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version must be positive")
+	}
 
 	if err := s.cargo.Update(
 		ctx,
@@ -166,6 +304,8 @@ func (s *serverAPI) Update(
 		weight,
 		volume,
 		vesselID,
+		portCallID,
+		req.ExpectedVersion,
 	); err != nil {
 		switch {
 		case errors.Is(err, storage.ErrCargoNotFound):
@@ -174,6 +314,14 @@ func (s *serverAPI) Update(
 			return nil, status.Error(codes.AlreadyExists, "cargo already exists")
 		case errors.Is(err, storage.ErrRelatedEntityNotFound):
 			return nil, status.Error(codes.FailedPrecondition, "one or more related entities not found")
+		case errors.Is(err, storage.ErrVesselOverloaded):
+			return nil, status.Error(codes.FailedPrecondition, "vessel max load exceeded")
+		case errors.Is(err, storage.ErrStorageLocNotSuitable):
+			return nil, status.Error(codes.FailedPrecondition, "cargo no longer fits its storage location")
+		case errors.Is(err, storage.ErrStorageLocTypeNotSuitable):
+			return nil, status.Error(codes.FailedPrecondition, "storage location type not suitable for this cargo")
+		case errors.Is(err, storage.ErrConcurrentModification):
+			return nil, status.Error(codes.Aborted, "cargo was modified concurrently")
 		default:
 			return nil, status.Error(codes.Internal, "failed to update cargo")
 		}
@@ -194,7 +342,7 @@ func (s *serverAPI) Delete(
 	if err := s.cargo.Delete(ctx, req.GetId()); err != nil {
 		switch {
 		case errors.Is(err, storage.ErrCargoInUse):
-			return nil, status.Error(codes.FailedPrecondition, "cargo is used")
+			return nil, status.Error(codes.FailedPrecondition, "cargo is placed in a storage location")
 		case errors.Is(err, storage.ErrCargoNotFound):
 			return nil, status.Error(codes.NotFound, "cargo not found")
 		default:
@@ -205,7 +353,145 @@ func (s *serverAPI) Delete(
 	return &cargov1.DeleteResponse{}, nil
 }
 
+func (s *serverAPI) Restore(
+	ctx context.Context,
+	req *cargov1.RestoreRequest,
+) (*cargov1.RestoreResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.cargo.Restore(ctx, req.GetId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCargoNotFound):
+			return nil, status.Error(codes.NotFound, "cargo not found")
+		case errors.Is(err, storage.ErrCargoNotArchived):
+			return nil, status.Error(codes.FailedPrecondition, "cargo is not archived")
+		case errors.Is(err, storage.ErrRelatedEntityNotFound):
+			return nil, status.Error(codes.FailedPrecondition, "vessel or cargo type is archived")
+		case errors.Is(err, storage.ErrVesselOverloaded):
+			return nil, status.Error(codes.FailedPrecondition, "vessel max load exceeded")
+		default:
+			return nil, status.Error(codes.Internal, "failed to restore cargo")
+		}
+	}
+
+	return &cargov1.RestoreResponse{}, nil
+}
+
+func (s *serverAPI) Transition(
+	ctx context.Context,
+	req *cargov1.TransitionRequest,
+) (*cargov1.TransitionResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	to, ok := cargoStatuses[req.GetStatus()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+	if to == models.CargoInStorage || to == models.CargoReleased {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"IN_STORAGE and RELEASED are set by StorageLocationService.Use and Reset",
+		)
+	}
+
+	var at time.Time
+	if req.GetAt() != nil {
+		at = req.GetAt().AsTime()
+	}
+
+	if err := s.cargo.Transition(ctx, req.GetId(), to, at); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCargoNotFound):
+			return nil, status.Error(codes.NotFound, "cargo not found")
+		case errors.Is(err, storage.ErrCargoStatusTransitionNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, "cargo status transition not allowed")
+		default:
+			return nil, status.Error(codes.Internal, "failed to change cargo status")
+		}
+	}
+
+	return &cargov1.TransitionResponse{}, nil
+}
+
+func (s *serverAPI) PlacementHistory(
+	ctx context.Context,
+	req *cargov1.PlacementHistoryRequest,
+) (*cargov1.PlacementHistoryResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.cargo.PlacementHistory(ctx, req.GetId(), models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "failed to get cargo placement history")
+	}
+
+	resp := make([]*cargov1.StoragePlacement, 0, len(page.Items))
+	for _, p := range page.Items {
+		var releasedAt *timestamppb.Timestamp
+		if p.ReleasedAt != nil {
+			releasedAt = timestamppb.New(*p.ReleasedAt)
+		}
+
+		resp = append(resp, &cargov1.StoragePlacement{
+			Id:                p.ID,
+			StorageLocationId: p.StorageLocID,
+			CargoId:           p.CargoID,
+			PlacedAt:          timestamppb.New(p.PlacedAt),
+			ReleasedAt:        releasedAt,
+		})
+	}
+
+	return &cargov1.PlacementHistoryResponse{
+		Placements:    resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+var cargoStatuses = map[cargov1.CargoStatus]models.CargoStatus{
+	cargov1.CargoStatus_CARGO_STATUS_EXPECTED:   models.CargoExpected,
+	cargov1.CargoStatus_CARGO_STATUS_ON_VESSEL:  models.CargoOnVessel,
+	cargov1.CargoStatus_CARGO_STATUS_UNLOADED:   models.CargoUnloaded,
+	cargov1.CargoStatus_CARGO_STATUS_IN_STORAGE: models.CargoInStorage,
+	cargov1.CargoStatus_CARGO_STATUS_RELEASED:   models.CargoReleased,
+	cargov1.CargoStatus_CARGO_STATUS_LOADED:     models.CargoLoaded,
+	cargov1.CargoStatus_CARGO_STATUS_DEPARTED:   models.CargoDeparted,
+}
+
+var protoCargoStatuses = map[models.CargoStatus]cargov1.CargoStatus{
+	models.CargoExpected:  cargov1.CargoStatus_CARGO_STATUS_EXPECTED,
+	models.CargoOnVessel:  cargov1.CargoStatus_CARGO_STATUS_ON_VESSEL,
+	models.CargoUnloaded:  cargov1.CargoStatus_CARGO_STATUS_UNLOADED,
+	models.CargoInStorage: cargov1.CargoStatus_CARGO_STATUS_IN_STORAGE,
+	models.CargoReleased:  cargov1.CargoStatus_CARGO_STATUS_RELEASED,
+	models.CargoLoaded:    cargov1.CargoStatus_CARGO_STATUS_LOADED,
+	models.CargoDeparted:  cargov1.CargoStatus_CARGO_STATUS_DEPARTED,
+}
+
 func toProtoCargo(c models.Cargo) *cargov1.Cargo {
+	var archivedAt *timestamppb.Timestamp
+	if c.DeletedAt != nil {
+		archivedAt = timestamppb.New(*c.DeletedAt)
+	}
+
     return &cargov1.Cargo{
         Id:         c.ID,
         Title:      c.Title,
@@ -213,5 +499,9 @@ func toProtoCargo(c models.Cargo) *cargov1.Cargo {
         Weight:    	c.Weight,
 		Volume: 	c.Volume,
 		VesselId: 	c.VesselID,	
+		PortCallId:	c.PortCallID,
+		Status:		protoCargoStatuses[c.Status],
+		Version:	c.Version,
+		ArchivedAt:	archivedAt,
     }
 }
\ No newline at end of file
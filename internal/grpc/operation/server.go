@@ -3,6 +3,7 @@ package operation
 import (
 	"context"
 	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	operationv1 "dbcp/protos/gen/go/operation"
 	"errors"
@@ -14,14 +15,16 @@ import (
 )
 
 type Operation interface {
-	List(ctx context.Context) ([]models.Operation, error)
+	List(ctx context.Context, page models.PageRequest) (models.Page[models.Operation], error)
 	Get(ctx context.Context, id int64) (models.Operation, error)
-	Create(ctx context.Context, title string) (int64, error)
+	Create(ctx context.Context, title string, kind models.OperationKind) (int64, error)
 	Delete(ctx context.Context, id int64) (error)
 	Update(
 		ctx context.Context, 
 		id int64,
 		title *string, 
+		kind *models.OperationKind,
+		expectedVersion *int64,
 	) (error)
 }
 
@@ -41,21 +44,34 @@ func Register(gRPCServer *grpc.Server, operation Operation) {
 
 func (s *serverAPI) List(
 	ctx context.Context,
-	_ *operationv1.ListRequest,
+	req *operationv1.ListRequest,
 ) (*operationv1.ListResponse, error) {
 
-	ops, err := s.operation.List(ctx)
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.operation.List(ctx, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, "failed to list operations")
 	}
 
-	resp := make([]*operationv1.Operation, 0, len(ops))
-	for _, op := range ops {
+	resp := make([]*operationv1.Operation, 0, len(page.Items))
+	for _, op := range page.Items {
 		resp = append(resp, toProtoOperation(op))
 	}
 
 	return &operationv1.ListResponse{
-		Operations: resp,
+		Operations:    resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -92,7 +108,16 @@ func (s *serverAPI) Create(
 		return nil, status.Error(codes.InvalidArgument, "title is required")
 	}
 
-	id, err := s.operation.Create(ctx, req.GetTitle())
+	var kind models.OperationKind
+	if req.GetKind() != operationv1.OperationKind_OPERATION_KIND_UNSPECIFIED {
+		k, ok := operationKinds[req.GetKind()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown operation kind")
+		}
+		kind = k
+	}
+
+	id, err := s.operation.Create(ctx, req.GetTitle(), kind)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create operation")
 	}
@@ -117,14 +142,31 @@ func (s *serverAPI) Update(
 		title = &t
 	}
 
+	var kind *models.OperationKind
+	if req.GetKind() != operationv1.OperationKind_OPERATION_KIND_UNSPECIFIED {
+		k, ok := operationKinds[req.GetKind()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown operation kind")
+		}
+		kind = &k
+	}
+
+	if req.ExpectedVersion != nil && req.GetExpectedVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version must be positive")
+	}
+
 	if err := s.operation.Update(
 		ctx,
 		req.GetId(),
 		title,
+		kind,
+		req.ExpectedVersion,
 	); err != nil {
 		switch {
 		case errors.Is(err, storage.ErrOperationNotFound):
 			return nil, status.Error(codes.NotFound, "operation not found")
+		case errors.Is(err, storage.ErrConcurrentModification):
+			return nil, status.Error(codes.Aborted, "operation was modified concurrently")
 		default:
 			return nil, status.Error(codes.Internal, "failed to update operation")
 		}
@@ -156,6 +198,32 @@ func (s *serverAPI) Delete(
 	return &operationv1.DeleteResponse{}, nil
 }
 
+var operationKinds = map[operationv1.OperationKind]models.OperationKind{
+	operationv1.OperationKind_OPERATION_KIND_UNLOADING:         models.OperationUnloading,
+	operationv1.OperationKind_OPERATION_KIND_LOADING:           models.OperationLoading,
+	operationv1.OperationKind_OPERATION_KIND_PLACEMENT:         models.OperationPlacement,
+	operationv1.OperationKind_OPERATION_KIND_RELEASE:           models.OperationRelease,
+	operationv1.OperationKind_OPERATION_KIND_TRANSFER:          models.OperationTransfer,
+	operationv1.OperationKind_OPERATION_KIND_INSPECTION:        models.OperationInspection,
+	operationv1.OperationKind_OPERATION_KIND_ARRIVAL:           models.OperationArrival,
+	operationv1.OperationKind_OPERATION_KIND_DEPARTURE:         models.OperationDeparture,
+	operationv1.OperationKind_OPERATION_KIND_CUSTOMS_CLEARANCE: models.OperationCustomsClearance,
+	operationv1.OperationKind_OPERATION_KIND_OTHER:             models.OperationOther,
+}
+
+var protoOperationKinds = map[models.OperationKind]operationv1.OperationKind{
+	models.OperationUnloading:        operationv1.OperationKind_OPERATION_KIND_UNLOADING,
+	models.OperationLoading:          operationv1.OperationKind_OPERATION_KIND_LOADING,
+	models.OperationPlacement:        operationv1.OperationKind_OPERATION_KIND_PLACEMENT,
+	models.OperationRelease:          operationv1.OperationKind_OPERATION_KIND_RELEASE,
+	models.OperationTransfer:         operationv1.OperationKind_OPERATION_KIND_TRANSFER,
+	models.OperationInspection:       operationv1.OperationKind_OPERATION_KIND_INSPECTION,
+	models.OperationArrival:          operationv1.OperationKind_OPERATION_KIND_ARRIVAL,
+	models.OperationDeparture:        operationv1.OperationKind_OPERATION_KIND_DEPARTURE,
+	models.OperationCustomsClearance: operationv1.OperationKind_OPERATION_KIND_CUSTOMS_CLEARANCE,
+	models.OperationOther:            operationv1.OperationKind_OPERATION_KIND_OTHER,
+}
+
 func toProtoOperation(
 	op models.Operation,
 ) *operationv1.Operation {
@@ -163,6 +231,8 @@ func toProtoOperation(
 	return &operationv1.Operation{
 		Id:    op.ID,
 		Title: op.Title,
+		Kind:  protoOperationKinds[op.Kind],
 		CreatedAt: timestamppb.New(op.CreatedAt),
+		Version: op.Version,
 	}
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package opercargo
 import (
 	"context"
 	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	opercargov1 "dbcp/protos/gen/go/opercargo"
 	"errors"
@@ -13,7 +14,7 @@ import (
 )
 
 type OperationCargo interface {
-	List(ctx context.Context) ([]models.OperationCargo, error)
+	List(ctx context.Context, page models.PageRequest) (models.Page[models.OperationCargo], error)
 	Create(ctx context.Context, operID, cargoID int64) (error)
 	Delete(ctx context.Context, operID, cargoID int64) (error)
 }
@@ -36,13 +37,24 @@ func (s *serverAPI) List(
 	ctx context.Context,
 	req *opercargov1.ListRequest,
 ) (*opercargov1.ListResponse, error) {
-	operCargos, err := s.operCargo.List(ctx)
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.operCargo.List(ctx, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, "failed to list operation cargos")
 	}
 
-	resp := make([]*opercargov1.OperationCargo, 0, len(operCargos))
-	for _, oc := range operCargos {
+	resp := make([]*opercargov1.OperationCargo, 0, len(page.Items))
+	for _, oc := range page.Items {
 		resp = append(resp, &opercargov1.OperationCargo{
 			OperationId: oc.OperationID,
 			CargoId:     oc.CargoID,
@@ -51,6 +63,8 @@ func (s *serverAPI) List(
 
 	return &opercargov1.ListResponse{
 		OperationsCargos: resp,
+		NextPageToken:    page.NextPageToken,
+		TotalCount:       page.TotalCount,
 	}, nil
 }
 
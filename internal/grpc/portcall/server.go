@@ -0,0 +1,313 @@
+package portcall
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
+	"dbcp/internal/storage"
+	portcallv1 "dbcp/protos/gen/go/portcall"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PortCall interface {
+	List(
+		ctx context.Context,
+		vesselID *int64,
+		page models.PageRequest,
+	) (models.Page[models.PortCall], error)
+	Get(ctx context.Context, id int64) (models.PortCall, error)
+	Create(ctx context.Context, portCall models.PortCall) (int64, error)
+	Update(
+		ctx context.Context,
+		id int64,
+		voyageNumber *string,
+		eta *time.Time,
+		etd *time.Time,
+	) error
+	Delete(ctx context.Context, id int64) error
+	Arrive(ctx context.Context, id int64, ata time.Time) error
+	Depart(ctx context.Context, id int64, atd time.Time) error
+	Cancel(ctx context.Context, id int64) error
+}
+
+type serverAPI struct {
+	portcallv1.UnimplementedPortCallServiceServer
+	portCall PortCall
+}
+
+func Register(gRPCServer *grpc.Server, portCall PortCall) {
+	portcallv1.RegisterPortCallServiceServer(
+		gRPCServer,
+		&serverAPI{
+			portCall: portCall,
+		},
+	)
+}
+
+func (s *serverAPI) List(
+	ctx context.Context,
+	req *portcallv1.ListRequest,
+) (*portcallv1.ListResponse, error) {
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.portCall.List(ctx, req.VesselId, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "failed to list port calls")
+	}
+
+	resp := make([]*portcallv1.PortCall, 0, len(page.Items))
+	for _, pc := range page.Items {
+		resp = append(resp, toProtoPortCall(pc))
+	}
+
+	return &portcallv1.ListResponse{
+		PortCalls:     resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *serverAPI) Get(
+	ctx context.Context,
+	req *portcallv1.GetRequest,
+) (*portcallv1.GetResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	pc, err := s.portCall.Get(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, storage.ErrPortCallNotFound) {
+			return nil, status.Error(codes.NotFound, "port call not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get port call")
+	}
+
+	return &portcallv1.GetResponse{PortCall: toProtoPortCall(pc)}, nil
+}
+
+func (s *serverAPI) Create(
+	ctx context.Context,
+	req *portcallv1.CreateRequest,
+) (*portcallv1.CreateResponse, error) {
+
+	if req.GetVesselId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "vessel_id is required")
+	}
+	if req.GetVoyageNumber() == "" {
+		return nil, status.Error(codes.InvalidArgument, "voyage_number is required")
+	}
+	if req.GetEta() == nil || req.GetEtd() == nil {
+		return nil, status.Error(codes.InvalidArgument, "eta and etd are required")
+	}
+	if req.GetEtd().AsTime().Before(req.GetEta().AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "etd must not be before eta")
+	}
+
+	id, err := s.portCall.Create(ctx, models.PortCall{
+		VesselID:     req.GetVesselId(),
+		VoyageNumber: req.GetVoyageNumber(),
+		ETA:          req.GetEta().AsTime(),
+		ETD:          req.GetEtd().AsTime(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPortCallExists):
+			return nil, status.Error(codes.AlreadyExists, "port call already exists")
+		case errors.Is(err, storage.ErrRelatedEntityNotFound):
+			return nil, status.Error(codes.FailedPrecondition, "vessel not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to create port call")
+		}
+	}
+
+	return &portcallv1.CreateResponse{Id: id}, nil
+}
+
+func (s *serverAPI) Update(
+	ctx context.Context,
+	req *portcallv1.UpdateRequest,
+) (*portcallv1.UpdateResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	var voyageNumber *string
+	if req.GetVoyageNumber() != "" {
+		vn := req.GetVoyageNumber()
+		voyageNumber = &vn
+	}
+
+	var eta *time.Time
+	if req.GetEta() != nil {
+		t := req.GetEta().AsTime()
+		eta = &t
+	}
+
+	var etd *time.Time
+	if req.GetEtd() != nil {
+		t := req.GetEtd().AsTime()
+		etd = &t
+	}
+
+	if err := s.portCall.Update(ctx, req.GetId(), voyageNumber, eta, etd); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPortCallNotFound):
+			return nil, status.Error(codes.NotFound, "port call not found")
+		case errors.Is(err, storage.ErrPortCallExists):
+			return nil, status.Error(codes.AlreadyExists, "port call already exists")
+		case errors.Is(err, storage.ErrPortCallStatusConflict):
+			return nil, status.Error(codes.InvalidArgument, "etd must not be before eta")
+		default:
+			return nil, status.Error(codes.Internal, "failed to update port call")
+		}
+	}
+
+	return &portcallv1.UpdateResponse{}, nil
+}
+
+func (s *serverAPI) Delete(
+	ctx context.Context,
+	req *portcallv1.DeleteRequest,
+) (*portcallv1.DeleteResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.portCall.Delete(ctx, req.GetId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrPortCallInUse):
+			return nil, status.Error(codes.FailedPrecondition, "port call is used")
+		case errors.Is(err, storage.ErrPortCallNotFound):
+			return nil, status.Error(codes.NotFound, "port call not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to delete port call")
+		}
+	}
+
+	return &portcallv1.DeleteResponse{}, nil
+}
+
+func (s *serverAPI) Arrive(
+	ctx context.Context,
+	req *portcallv1.ArriveRequest,
+) (*portcallv1.ArriveResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	ata := time.Now()
+	if req.GetAta() != nil {
+		ata = req.GetAta().AsTime()
+		if ata.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "ata cannot be in the future")
+		}
+	}
+
+	if err := s.portCall.Arrive(ctx, req.GetId(), ata); err != nil {
+		return nil, statusTransitionError(err, "failed to record arrival")
+	}
+
+	return &portcallv1.ArriveResponse{}, nil
+}
+
+func (s *serverAPI) Depart(
+	ctx context.Context,
+	req *portcallv1.DepartRequest,
+) (*portcallv1.DepartResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	atd := time.Now()
+	if req.GetAtd() != nil {
+		atd = req.GetAtd().AsTime()
+		if atd.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "atd cannot be in the future")
+		}
+	}
+
+	if err := s.portCall.Depart(ctx, req.GetId(), atd); err != nil {
+		return nil, statusTransitionError(err, "failed to record departure")
+	}
+
+	return &portcallv1.DepartResponse{}, nil
+}
+
+func (s *serverAPI) Cancel(
+	ctx context.Context,
+	req *portcallv1.CancelRequest,
+) (*portcallv1.CancelResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.portCall.Cancel(ctx, req.GetId()); err != nil {
+		return nil, statusTransitionError(err, "failed to cancel port call")
+	}
+
+	return &portcallv1.CancelResponse{}, nil
+}
+
+func statusTransitionError(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, storage.ErrPortCallNotFound):
+		return status.Error(codes.NotFound, "port call not found")
+	case errors.Is(err, storage.ErrPortCallStatusConflict):
+		return status.Error(codes.FailedPrecondition, "port call status does not allow this action")
+	default:
+		return status.Error(codes.Internal, internalMsg)
+	}
+}
+
+var protoPortCallStatuses = map[models.PortCallStatus]portcallv1.PortCallStatus{
+	models.PortCallScheduled: portcallv1.PortCallStatus_PORT_CALL_STATUS_SCHEDULED,
+	models.PortCallArrived:   portcallv1.PortCallStatus_PORT_CALL_STATUS_ARRIVED,
+	models.PortCallDeparted:  portcallv1.PortCallStatus_PORT_CALL_STATUS_DEPARTED,
+	models.PortCallCancelled: portcallv1.PortCallStatus_PORT_CALL_STATUS_CANCELLED,
+}
+
+func toProtoPortCall(pc models.PortCall) *portcallv1.PortCall {
+	var ata *timestamppb.Timestamp
+	if pc.ATA != nil {
+		ata = timestamppb.New(*pc.ATA)
+	}
+
+	var atd *timestamppb.Timestamp
+	if pc.ATD != nil {
+		atd = timestamppb.New(*pc.ATD)
+	}
+
+	return &portcallv1.PortCall{
+		Id:           pc.ID,
+		VesselId:     pc.VesselID,
+		VoyageNumber: pc.VoyageNumber,
+		Status:       protoPortCallStatuses[pc.Status],
+		Eta:          timestamppb.New(pc.ETA),
+		Etd:          timestamppb.New(pc.ETD),
+		Ata:          ata,
+		Atd:          atd,
+	}
+}
@@ -3,6 +3,7 @@ package storageloc
 import (
 	"context"
 	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	storagelocv1 "dbcp/protos/gen/go/storageloc"
 	"errors"
@@ -15,7 +16,7 @@ import (
 )
 
 type StorageLoc interface {
-	List(ctx context.Context) ([]models.StorageLocation, error)
+	List(ctx context.Context, page models.PageRequest) (models.Page[models.StorageLocation], error)
 	Get(ctx context.Context, id int64) (models.StorageLocation, error)
 	Delete(ctx context.Context, id int64) error
 	Create(
@@ -30,6 +31,7 @@ type StorageLoc interface {
 		cargoTypeID *int64,
 		maxWeight *float64,
 		maxVolume *float64,
+		expectedVersion *int64,
 	) error
 	Use(
 		ctx context.Context,
@@ -37,7 +39,34 @@ type StorageLoc interface {
 		cargoId int64,
 		date time.Time,
 	) error
-	Reset(ctx context.Context, id int64) error
+	Reset(ctx context.Context, id int64, cargoID *int64) error
+	History(
+		ctx context.Context,
+		id int64,
+		page models.PageRequest,
+	) (models.Page[models.StoragePlacement], error)
+	Contents(
+		ctx context.Context,
+		id int64,
+		page models.PageRequest,
+	) (models.Page[models.StoredCargo], error)
+	SuggestPlacement(
+		ctx context.Context,
+		cargoID int64,
+		strategy models.PlacementStrategy,
+		limit int32,
+	) ([]models.PlacementCandidate, error)
+	AutoPlaceVessel(
+		ctx context.Context,
+		vesselID int64,
+		date time.Time,
+	) (models.AutoPlacementResult, error)
+	Move(
+		ctx context.Context,
+		cargoID int64,
+		toID int64,
+		date time.Time,
+	) (int64, error)
 }
 
 type serverAPI struct {
@@ -56,21 +85,34 @@ func Register(gRPCServer *grpc.Server, storageLocation StorageLoc) {
 
 func (s *serverAPI) List(
 	ctx context.Context,
-	_ *storagelocv1.ListRequest,
+	req *storagelocv1.ListRequest,
 ) (*storagelocv1.ListResponse, error) {
 
-	locs, err := s.storageLocation.List(ctx)
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.storageLocation.List(ctx, models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, "failed to list storage locations")
 	}
 
-	resp := make([]*storagelocv1.StorageLocation, 0, len(locs))
-	for _, l := range locs {
+	resp := make([]*storagelocv1.StorageLocation, 0, len(page.Items))
+	for _, l := range page.Items {
 		resp = append(resp, toProtoStorageLoc(l))
 	}
 
 	return &storagelocv1.ListResponse{
 		StorageLocations: resp,
+		NextPageToken:    page.NextPageToken,
+		TotalCount:       page.TotalCount,
 	}, nil
 }
 
@@ -156,12 +198,17 @@ func (s *serverAPI) Update(
 		maxVolume = &v
 	}
 
+	if req.ExpectedVersion != nil && req.GetExpectedVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version must be positive")
+	}
+
 	err := s.storageLocation.Update(
 		ctx,
 		req.GetId(),
 		cargoTypeID,
 		maxWeight,
 		maxVolume,
+		req.ExpectedVersion,
 	)
 	if err != nil {
 		switch {
@@ -169,6 +216,10 @@ func (s *serverAPI) Update(
 			return nil, status.Error(codes.NotFound, "storage location not found")
 		case errors.Is(err, storage.ErrRelatedEntityNotFound):
 			return nil, status.Error(codes.NotFound, "related entity not found")
+		case errors.Is(err, storage.ErrStorageLocInUse):
+			return nil, status.Error(codes.FailedPrecondition, "placed cargo does not fit the new storage location parameters")
+		case errors.Is(err, storage.ErrConcurrentModification):
+			return nil, status.Error(codes.Aborted, "storage location was modified concurrently")
 		default:
 			return nil, status.Error(codes.Internal, "failed to update storage location")
 		}
@@ -191,6 +242,8 @@ func (s *serverAPI) Delete(
 		switch {
 		case errors.Is(err, storage.ErrStorageLocInUse):
 			return nil, status.Error(codes.FailedPrecondition, "storage location is used")
+		case errors.Is(err, storage.ErrStorageLocHasHistory):
+			return nil, status.Error(codes.FailedPrecondition, "storage location has placement history")
 		case errors.Is(err, storage.ErrStorageLocNotFound):
 			return nil, status.Error(codes.NotFound, "storage location not found")
 		default:
@@ -244,6 +297,8 @@ func (s *serverAPI) Use(
 			return nil, status.Error(codes.FailedPrecondition, "storage location type not suitable for this cargo")
 		case errors.Is(err, storage.ErrCargoAlreadyPlaced):
 			return nil, status.Error(codes.FailedPrecondition, "cargo is already placed in a storage location")
+		case errors.Is(err, storage.ErrCargoStatusTransitionNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, "cargo status does not allow placement")
 		default:
 			return nil, status.Error(codes.Internal, "failed to use storage location")
 		}
@@ -261,13 +316,21 @@ func (s *serverAPI) Reset(
 		return nil, status.Error(codes.InvalidArgument, "id is must be positive")
 	}
 
-	err := s.storageLocation.Reset(ctx, req.GetId())
+	if req.CargoId != nil && req.GetCargoId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "cargo_id must be positive")
+	}
+
+	err := s.storageLocation.Reset(ctx, req.GetId(), req.CargoId)
 	if err != nil {
 		switch {
+		case errors.Is(err, storage.ErrCargoNotPlaced):
+			return nil, status.Error(codes.FailedPrecondition, "cargo is not placed in this storage location")
 		case errors.Is(err, storage.ErrStorageLocAlreadyEmpty):
 			return nil, status.Error(codes.FailedPrecondition, "stiorage location is already empty")
 		case errors.Is(err, storage.ErrStorageLocNotFound):
 			return nil, status.Error(codes.NotFound, "storage location not found")
+		case errors.Is(err, storage.ErrCargoStatusTransitionNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, "cargo status does not allow release")
 		default:
 			return nil, status.Error(codes.Internal, "failed to reset storage location")
 		}
@@ -276,25 +339,270 @@ func (s *serverAPI) Reset(
 	return &storagelocv1.ResetResponse{}, nil
 }
 
+func (s *serverAPI) History(
+	ctx context.Context,
+	req *storagelocv1.HistoryRequest,
+) (*storagelocv1.HistoryResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.storageLocation.History(ctx, req.GetId(), models.PageRequest{
+		Size:      req.GetPageSize(),
+		Token:     req.GetPageToken(),
+		WithTotal: req.GetWithTotalCount(),
+	})
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.Internal, "failed to get storage location history")
+	}
+
+	resp := make([]*storagelocv1.StoragePlacement, 0, len(page.Items))
+	for _, p := range page.Items {
+		resp = append(resp, toProtoStoragePlacement(p))
+	}
+
+	return &storagelocv1.HistoryResponse{
+		Placements:    resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *serverAPI) Contents(
+	ctx context.Context,
+	req *storagelocv1.ContentsRequest,
+) (*storagelocv1.ContentsResponse, error) {
+
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.storageLocation.Contents(ctx, req.GetId(), models.PageRequest{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pagination.ErrInvalidPageToken):
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		case errors.Is(err, storage.ErrStorageLocNotFound):
+			return nil, status.Error(codes.NotFound, "storage location not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to get storage location contents")
+		}
+	}
+
+	resp := make([]*storagelocv1.StoredCargo, 0, len(page.Items))
+	for _, c := range page.Items {
+		resp = append(resp, &storagelocv1.StoredCargo{
+			PlacementId: c.PlacementID,
+			CargoId:     c.CargoID,
+			Title:       c.Title,
+			TypeId:      c.TypeID,
+			Weight:      c.Weight,
+			Volume:      c.Volume,
+			PlacedAt:    timestamppb.New(c.PlacedAt),
+		})
+	}
+
+	return &storagelocv1.ContentsResponse{
+		Cargos:        resp,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *serverAPI) SuggestPlacement(
+	ctx context.Context,
+	req *storagelocv1.SuggestPlacementRequest,
+) (*storagelocv1.SuggestPlacementResponse, error) {
+
+	if req.GetCargoId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "cargo_id must be positive")
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	var strategy models.PlacementStrategy
+	switch req.GetStrategy() {
+	case storagelocv1.PlacementStrategy_PLACEMENT_STRATEGY_UNSPECIFIED,
+		storagelocv1.PlacementStrategy_PLACEMENT_STRATEGY_BEST_FIT:
+		strategy = models.PlacementBestFit
+	case storagelocv1.PlacementStrategy_PLACEMENT_STRATEGY_WORST_FIT:
+		strategy = models.PlacementWorstFit
+	case storagelocv1.PlacementStrategy_PLACEMENT_STRATEGY_FIRST_FIT:
+		strategy = models.PlacementFirstFit
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown strategy")
+	}
+
+	candidates, err := s.storageLocation.SuggestPlacement(ctx, req.GetCargoId(), strategy, req.GetLimit())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCargoNotFound):
+			return nil, status.Error(codes.NotFound, "cargo not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to suggest placement")
+		}
+	}
+
+	resp := make([]*storagelocv1.PlacementCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		resp = append(resp, &storagelocv1.PlacementCandidate{
+			StorageLocation: toProtoStorageLoc(c.StorageLocation),
+			RemainingWeight: c.RemainingWeight,
+			RemainingVolume: c.RemainingVolume,
+			Score:           c.Score,
+		})
+	}
+
+	return &storagelocv1.SuggestPlacementResponse{Candidates: resp}, nil
+}
+
+func (s *serverAPI) AutoPlaceVessel(
+	ctx context.Context,
+	req *storagelocv1.AutoPlaceVesselRequest,
+) (*storagelocv1.AutoPlaceVesselResponse, error) {
+
+	if req.GetVesselId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "vessel_id must be positive")
+	}
+
+	var date time.Time
+	if req.GetDateOfPlacement() != nil {
+		date = req.GetDateOfPlacement().AsTime()
+		if date.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument,
+				"date_of_placement cannot be in the future")
+		}
+	}
+
+	result, err := s.storageLocation.AutoPlaceVessel(ctx, req.GetVesselId(), date)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrVesselNotFound):
+			return nil, status.Error(codes.NotFound, "vessel not found")
+		case errors.Is(err, storage.ErrCargoStatusTransitionNotAllowed),
+			errors.Is(err, storage.ErrCargoAlreadyPlaced):
+			return nil, status.Error(codes.Aborted, "cargo was placed concurrently, retry")
+		default:
+			return nil, status.Error(codes.Internal, "failed to place vessel cargo")
+		}
+	}
+
+	placed := make([]*storagelocv1.PlacedCargo, 0, len(result.Placed))
+	for _, p := range result.Placed {
+		placed = append(placed, &storagelocv1.PlacedCargo{
+			CargoId:           p.CargoID,
+			StorageLocationId: p.StorageLocID,
+		})
+	}
+
+	unplaced := make([]*storagelocv1.UnplacedCargo, 0, len(result.Unplaced))
+	for _, u := range result.Unplaced {
+		reason := storagelocv1.UnplacedReason_UNPLACED_REASON_UNSPECIFIED
+		switch {
+		case errors.Is(u.Reason, storage.ErrStorageLocTypeNotSuitable):
+			reason = storagelocv1.UnplacedReason_UNPLACED_REASON_TYPE_NOT_SUITABLE
+		case errors.Is(u.Reason, storage.ErrStorageLocNotSuitable):
+			reason = storagelocv1.UnplacedReason_UNPLACED_REASON_NOT_SUITABLE
+		}
+
+		unplaced = append(unplaced, &storagelocv1.UnplacedCargo{
+			CargoId: u.CargoID,
+			Reason:  reason,
+			Message: u.Reason.Error(),
+		})
+	}
+
+	return &storagelocv1.AutoPlaceVesselResponse{
+		Placed:   placed,
+		Unplaced: unplaced,
+	}, nil
+}
+
+func (s *serverAPI) Move(
+	ctx context.Context,
+	req *storagelocv1.MoveRequest,
+) (*storagelocv1.MoveResponse, error) {
+
+	if req.GetCargoId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "cargo_id must be positive")
+	}
+	if req.GetToStorageLocationId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "to_storage_location_id must be positive")
+	}
+
+	var date time.Time
+	if req.GetDateOfMove() != nil {
+		date = req.GetDateOfMove().AsTime()
+		if date.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument,
+				"date_of_move cannot be in the future")
+		}
+	}
+
+	fromID, err := s.storageLocation.Move(ctx, req.GetCargoId(), req.GetToStorageLocationId(), date)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCargoNotFound):
+			return nil, status.Error(codes.NotFound, "cargo not found")
+		case errors.Is(err, storage.ErrStorageLocNotFound):
+			return nil, status.Error(codes.NotFound, "storage location not found")
+		case errors.Is(err, storage.ErrCargoNotPlaced):
+			return nil, status.Error(codes.FailedPrecondition, "cargo is not placed in a storage location")
+		case errors.Is(err, storage.ErrCargoAlreadyPlaced):
+			return nil, status.Error(codes.FailedPrecondition, "cargo is already in the target storage location")
+		case errors.Is(err, storage.ErrStorageLocTypeNotSuitable):
+			return nil, status.Error(codes.FailedPrecondition, "storage location type not suitable for this cargo")
+		case errors.Is(err, storage.ErrStorageLocNotSuitable):
+			return nil, status.Error(codes.FailedPrecondition, "storage location not suitable for this cargo")
+		default:
+			return nil, status.Error(codes.Internal, "failed to move cargo")
+		}
+	}
+
+	return &storagelocv1.MoveResponse{FromStorageLocationId: fromID}, nil
+}
+
 func toProtoStorageLoc(
 	sl models.StorageLocation,
 ) *storagelocv1.StorageLocation {
-	var cargoID *int64
-	if sl.CargoID != nil {
-		cargoID = sl.CargoID
+	return &storagelocv1.StorageLocation{
+		Id:          sl.ID,
+		CargoTypeId: sl.CargoTypeID,
+		MaxWeight:   sl.MaxWeight,
+		MaxVolume:   sl.MaxVolume,
+		UsedWeight:  sl.UsedWeight,
+		UsedVolume:  sl.UsedVolume,
+		CargoCount:  sl.CargoCount,
+		Version:     sl.Version,
 	}
+}
 
-	var date *timestamppb.Timestamp
-	if sl.DateOfPlacement != nil {
-		date = timestamppb.New(*sl.DateOfPlacement)
+func toProtoStoragePlacement(
+	p models.StoragePlacement,
+) *storagelocv1.StoragePlacement {
+	var releasedAt *timestamppb.Timestamp
+	if p.ReleasedAt != nil {
+		releasedAt = timestamppb.New(*p.ReleasedAt)
 	}
 
-	return &storagelocv1.StorageLocation{
-		Id:              sl.ID,
-		CargoTypeId:     sl.CargoTypeID,
-		MaxWeight:       sl.MaxWeight,
-		MaxVolume:       sl.MaxVolume,
-		CargoId:         cargoID,
-		DateOfPlacement: date,
+	return &storagelocv1.StoragePlacement{
+		Id:                p.ID,
+		StorageLocationId: p.StorageLocID,
+		CargoId:           p.CargoID,
+		PlacedAt:          timestamppb.New(p.PlacedAt),
+		ReleasedAt:        releasedAt,
 	}
 }
@@ -3,6 +3,7 @@ package vessel
 import (
 	"context"
 	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	vesselv1 "dbcp/protos/gen/go/vessel"
 	"errors"
@@ -10,19 +11,27 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Vessel interface {
-	List(ctx context.Context) ([]models.Vessel, error)
-	Get(ctx context.Context, id int64) (models.Vessel, error)
+	List(
+		ctx context.Context,
+		page models.PageRequest,
+		includeArchived bool,
+	) (models.Page[models.Vessel], error)
+	Get(ctx context.Context, id int64, includeArchived bool) (models.Vessel, error)
 	Create(ctx context.Context, vessel models.Vessel) (int64, error)
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	GetLoad(ctx context.Context, id int64) (models.VesselLoad, error)
 	Update(
 		ctx context.Context,
 		id int64,
 		title *string,
 		vesselType *string,
 		maxLoad *float64,
+		expectedVersion *int64,
 	) error
 }
 
@@ -40,22 +49,32 @@ func (s *serverAPI) List(
 	lv *vesselv1.ListRequest,
 ) (*vesselv1.ListResponse, error) {
 
-	vessels, err := s.vessel.List(ctx)
+	if lv.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	page, err := s.vessel.List(ctx, models.PageRequest{
+		Size:      lv.GetPageSize(),
+		Token:     lv.GetPageToken(),
+		WithTotal: lv.GetWithTotalCount(),
+	}, lv.GetIncludeArchived())
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
 		return nil, status.Error(codes.Internal, "failed to list vessels")
 	}
 
-	resp := make([]*vesselv1.Vessel, 0, len(vessels))
-	for _, v := range vessels {
-		resp = append(resp, &vesselv1.Vessel{
-			Id:         v.ID,
-			Title:      v.Title,
-			VesselType: v.VesselType,
-			MaxLoad:    v.MaxLoad,
-		})
+	resp := make([]*vesselv1.Vessel, 0, len(page.Items))
+	for _, v := range page.Items {
+		resp = append(resp, toProtoVessel(v))
 	}
 
-	return &vesselv1.ListResponse{Vessels: resp}, nil
+	return &vesselv1.ListResponse{
+		Vessels:       resp,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
 }
 
 func (s *serverAPI) Get(
@@ -65,7 +84,7 @@ func (s *serverAPI) Get(
 	if gv.GetId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	vessel, err := s.vessel.Get(ctx, gv.GetId())
+	vessel, err := s.vessel.Get(ctx, gv.GetId(), gv.GetIncludeArchived())
 	if err != nil {
 		switch {
 		case errors.Is(err, storage.ErrVesselNotFound):
@@ -117,6 +136,9 @@ func (s *serverAPI) Update(
 	if uv.GetId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
+	if uv.ExpectedVersion != nil && uv.GetExpectedVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version must be positive")
+	}
 
 	var title *string
 	if uv.GetTitle() != "" {
@@ -142,6 +164,7 @@ func (s *serverAPI) Update(
 		title,
 		vesselType,
 		maxLoad,
+		uv.ExpectedVersion,
 	)
 	if err != nil {
 		switch {
@@ -149,6 +172,10 @@ func (s *serverAPI) Update(
 			return nil, status.Error(codes.NotFound, "vessel not found")
 		case errors.Is(err, storage.ErrVesselExists):
 			return nil, status.Error(codes.AlreadyExists, "vessel already exists")
+		case errors.Is(err, storage.ErrVesselOverloaded):
+			return nil, status.Error(codes.FailedPrecondition, "max load is below the weight of assigned cargo")
+		case errors.Is(err, storage.ErrConcurrentModification):
+			return nil, status.Error(codes.Aborted, "vessel was modified concurrently")
 		default:
 			return nil, status.Error(codes.Internal, "failed to update vessel")
 		}
@@ -167,8 +194,6 @@ func (s *serverAPI) Delete(
 
 	if err := s.vessel.Delete(ctx, dv.GetId()); err != nil {
 		switch {
-		case errors.Is(err, storage.ErrVesselInUse):
-			return nil, status.Error(codes.FailedPrecondition, "vessel is used")
 		case errors.Is(err, storage.ErrVesselNotFound):
 			return nil, status.Error(codes.NotFound, "vessel not found")
 		default:
@@ -179,11 +204,69 @@ func (s *serverAPI) Delete(
 	return &vesselv1.DeleteResponse{}, nil
 }
 
+func (s *serverAPI) Restore(
+	ctx context.Context,
+	rv *vesselv1.RestoreRequest,
+) (*vesselv1.RestoreResponse, error) {
+	if rv.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.vessel.Restore(ctx, rv.GetId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrVesselNotFound):
+			return nil, status.Error(codes.NotFound, "vessel not found")
+		case errors.Is(err, storage.ErrVesselNotArchived):
+			return nil, status.Error(codes.FailedPrecondition, "vessel is not archived")
+		case errors.Is(err, storage.ErrVesselExists):
+			return nil, status.Error(codes.AlreadyExists, "vessel already exists")
+		default:
+			return nil, status.Error(codes.Internal, "failed to restore vessel")
+		}
+	}
+
+	return &vesselv1.RestoreResponse{}, nil
+}
+
+func (s *serverAPI) GetLoad(
+	ctx context.Context,
+	req *vesselv1.GetLoadRequest,
+) (*vesselv1.GetLoadResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	load, err := s.vessel.GetLoad(ctx, req.GetId())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrVesselNotFound):
+			return nil, status.Error(codes.NotFound, "vessel not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to get vessel load")
+		}
+	}
+
+	return &vesselv1.GetLoadResponse{
+		VesselId:           load.VesselID,
+		MaxLoad:            load.MaxLoad,
+		CurrentLoad:        load.CurrentLoad,
+		RemainingCapacity:  load.RemainingCapacity,
+		UtilisationPercent: load.UtilisationPercent,
+	}, nil
+}
+
 func toProtoVessel(v models.Vessel) *vesselv1.Vessel {
+	var archivedAt *timestamppb.Timestamp
+	if v.DeletedAt != nil {
+		archivedAt = timestamppb.New(*v.DeletedAt)
+	}
+
 	return &vesselv1.Vessel{
 		Id:         v.ID,
 		Title:      v.Title,
 		VesselType: v.VesselType,
 		MaxLoad:    v.MaxLoad,
+		Version:    v.Version,
+		ArchivedAt: archivedAt,
 	}
 }
@@ -0,0 +1,39 @@
+package actor
+
+import "context"
+
+// Anonymous is recorded for changes made by a caller that did not
+// identify itself.
+const Anonymous = "anonymous"
+
+type ctxKey struct{}
+
+// WithActor returns a copy of ctx that carries the name of the caller
+// making the change.
+func WithActor(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, name)
+}
+
+// FromContext returns the caller stored in ctx, or Anonymous.
+func FromContext(ctx context.Context) string {
+	if name, ok := ctx.Value(ctxKey{}).(string); ok && name != "" {
+		return name
+	}
+	return Anonymous
+}
+
+type claimedKey struct{}
+
+// WithClaimed returns a copy of ctx that carries the name an
+// unauthenticated caller gave for itself. Nothing vouches for it, so it
+// is kept apart from the actor.
+func WithClaimed(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, claimedKey{}, name)
+}
+
+// ClaimedFromContext returns the name the caller claimed, or an empty
+// string.
+func ClaimedFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(claimedKey{}).(string)
+	return name
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// Principal is the authenticated caller of the API.
+type Principal struct {
+	// Name identifies the caller: the name of its API key, the subject
+	// of its token or the name in its client certificate. It is recorded
+	// as the actor in the audit log.
+	Name string
+	// Method is how the caller authenticated, one of the Method constants.
+	Method string
+	Role   Role
+}
+
+const (
+	MethodAPIKey     = "api_key"
+	MethodJWT        = "jwt"
+	MethodClientCert = "client_cert"
+)
+
+// Role grants access to the API. Roles are ordered: each one may do
+// everything the roles below it may.
+type Role int
+
+const (
+	RoleViewer Role = iota + 1
+	RoleDispatcher
+	RoleWarehouseOperator
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleViewer:            "viewer",
+	RoleDispatcher:        "dispatcher",
+	RoleWarehouseOperator: "warehouse_operator",
+	RoleAdmin:             "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// ParseRole returns the role with the given name.
+func ParseRole(name string) (Role, error) {
+	for r, n := range roleNames {
+		if n == name {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown role %q", name)
+}
+
+type ctxKey struct{}
+
+// WithPrincipal returns a copy of ctx that carries the authenticated
+// caller.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext returns the caller stored in ctx. ok is false when the call
+// was not authenticated, e.g. when authentication is turned off.
+func FromContext(ctx context.Context) (p Principal, ok bool) {
+	p, ok = ctx.Value(ctxKey{}).(Principal)
+	return p, ok
+}
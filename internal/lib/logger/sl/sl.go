@@ -1,12 +1,29 @@
 package sl
 
 import (
+	"context"
 	"log/slog"
 )
 
+type ctxKey struct{}
+
 func Err(err error) slog.Attr {
 	return slog.Attr{
 		Key:   "error",
 		Value: slog.StringValue(err.Error()),
 	}
+}
+
+// WithLogger returns a copy of ctx that carries the request-scoped logger.
+func WithLogger(ctx context.Context, log *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, log)
+}
+
+// FromContext returns the logger stored in ctx, or fallback when the
+// context carries none.
+func FromContext(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	if log, ok := ctx.Value(ctxKey{}).(*slog.Logger); ok && log != nil {
+		return log
+	}
+	return fallback
 }
\ No newline at end of file
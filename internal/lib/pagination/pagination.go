@@ -0,0 +1,123 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+
+	// offsetMarker starts offset tokens, so that they cannot be taken
+	// for keyset tokens or the other way round.
+	offsetMarker = "o"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Size normalizes the requested page size: zero means the default,
+// anything above MaxPageSize is capped.
+func Size(requested int32) int {
+	switch {
+	case requested <= 0:
+		return DefaultPageSize
+	case requested > MaxPageSize:
+		return MaxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// EncodeToken packs the keyset cursor of the last returned row
+// into an opaque page token.
+func EncodeToken(keys ...int64) string {
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, strconv.FormatInt(k, 10))
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, ":")))
+}
+
+// DecodeToken unpacks a page token produced by EncodeToken.
+// An empty token is the first page and yields n zero keys.
+func DecodeToken(token string, n int) ([]int64, error) {
+	keys := make([]int64, n)
+	if token == "" {
+		return keys, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != n {
+		return nil, ErrInvalidPageToken
+	}
+
+	for i, p := range parts {
+		k, err := strconv.ParseInt(p, 10, 64)
+		if err != nil || k < 0 {
+			return nil, ErrInvalidPageToken
+		}
+		keys[i] = k
+	}
+
+	return keys, nil
+}
+
+// Trim cuts items fetched with limit+1 down to limit and returns
+// the token of the next page, or an empty string on the last page.
+func Trim[T any](items []T, limit int, cursor func(T) []int64) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+
+	items = items[:limit]
+	return items, EncodeToken(cursor(items[limit-1])...)
+}
+
+// EncodeOffsetToken packs the offset of the next page of a query whose
+// order has no stable keyset. scope identifies the query, e.g. its filter
+// and sort order; the token is only valid for the same scope.
+func EncodeOffsetToken(scope string, offset int64) string {
+	raw := offsetMarker + ":" + scopeHash(scope) + ":" + strconv.FormatInt(offset, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeOffsetToken unpacks a token produced by EncodeOffsetToken for the
+// same scope. An empty token is the first page and yields zero.
+func DecodeOffsetToken(token string, scope string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[0] != offsetMarker || parts[1] != scopeHash(scope) {
+		return 0, ErrInvalidPageToken
+	}
+
+	offset, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return offset, nil
+}
+
+func scopeHash(scope string) string {
+	h := fnv.New64a()
+	h.Write([]byte(scope))
+	return strconv.FormatUint(h.Sum64(), 36)
+}
@@ -0,0 +1,82 @@
+package auditservice
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	opStart = "services.audit"
+)
+
+type AuditService struct {
+	log *slog.Logger
+	aProvider AuditProvider
+}
+
+type AuditProvider interface {
+	AuditRecords(
+		ctx context.Context,
+		filter models.AuditFilter,
+		beforeID int64,
+		limit int,
+	) ([]models.AuditRecord, error)
+	CountAuditRecords(ctx context.Context, filter models.AuditFilter) (int64, error)
+}
+
+func New(
+	log *slog.Logger,
+	aProvider AuditProvider,
+) *AuditService {
+	return &AuditService{
+		log: log,
+		aProvider: aProvider,
+	}
+}
+
+// Query returns the audit records matching the filter, newest first.
+func (a *AuditService) Query(
+	ctx context.Context,
+	filter models.AuditFilter,
+	page models.PageRequest,
+) (models.Page[models.AuditRecord], error) {
+	const op = opStart + ".Query"
+
+	log := sl.FromContext(ctx, a.log).With(slog.String("op", op))
+	log.Info("Querying audit log")
+
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return models.Page[models.AuditRecord]{}, fmt.Errorf("%s: to is before from", op)
+	}
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.AuditRecord]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	records, err := a.aProvider.AuditRecords(ctx, filter, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to query audit log", sl.Err(err))
+		return models.Page[models.AuditRecord]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.AuditRecord]
+	result.Items, result.NextPageToken = pagination.Trim(records, limit,
+		func(r models.AuditRecord) []int64 { return []int64{r.ID} })
+
+	if page.WithTotal {
+		total, err := a.aProvider.CountAuditRecords(ctx, filter)
+		if err != nil {
+			log.Error("failed to count audit records", sl.Err(err))
+			return models.Page[models.AuditRecord]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
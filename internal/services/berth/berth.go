@@ -0,0 +1,185 @@
+package berthservice
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	opStart = "services.berth"
+)
+
+type BerthService struct {
+	log *slog.Logger
+	bProvider BerthProvider
+}
+
+type BerthProvider interface {
+	Berths(ctx context.Context, afterID int64, limit int) ([]models.Berth, error)
+	CountBerths(ctx context.Context) (int64, error)
+	SaveBerth(ctx context.Context, berth models.Berth) (int64, error)
+	Berth(ctx context.Context, id int64) (models.Berth, error)
+	UpdateBerth(
+		ctx context.Context,
+		id int64,
+		title *string,
+		length *float64,
+		maxDraft *float64,
+		allowedVesselTypes *[]string,
+	) error
+	DeleteBerth(ctx context.Context, id int64) error
+}
+
+func New(
+	log *slog.Logger,
+	bProvider BerthProvider,
+) *BerthService {
+	return &BerthService{
+		log: log,
+		bProvider: bProvider,
+	}
+}
+
+func (b *BerthService) List(
+	ctx context.Context,
+	page models.PageRequest,
+) (models.Page[models.Berth], error) {
+	const op = opStart + ".List"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op))
+	log.Info("Listing berths")
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.Berth]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	berths, err := b.bProvider.Berths(ctx, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to list berths", sl.Err(err))
+		return models.Page[models.Berth]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.Berth]
+	result.Items, result.NextPageToken = pagination.Trim(berths, limit,
+		func(b models.Berth) []int64 { return []int64{b.ID} })
+
+	if page.WithTotal {
+		total, err := b.bProvider.CountBerths(ctx)
+		if err != nil {
+			log.Error("failed to count berths", sl.Err(err))
+			return models.Page[models.Berth]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
+
+func (b *BerthService) Get(
+	ctx context.Context,
+	id int64,
+) (models.Berth, error) {
+	const op = opStart + ".Get"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return models.Berth{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	berth, err := b.bProvider.Berth(ctx, id)
+	if err != nil {
+		log.Error("failed to get berth", sl.Err(err))
+		return models.Berth{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth received")
+	return berth, nil
+}
+
+func (b *BerthService) Create(
+	ctx context.Context,
+	berth models.Berth,
+) (int64, error) {
+	const op = opStart + ".Create"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op), slog.String("title", berth.Title))
+
+	if berth.Title == "" {
+		return 0, fmt.Errorf("%s: title is required", op)
+	}
+	if berth.Length <= 0 {
+		return 0, fmt.Errorf("%s: length must be positive", op)
+	}
+	if berth.MaxDraft <= 0 {
+		return 0, fmt.Errorf("%s: maxDraft must be positive", op)
+	}
+
+	id, err := b.bProvider.SaveBerth(ctx, berth)
+	if err != nil {
+		log.Error("failed to create berth", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth created", slog.Int64("id", id))
+	return id, nil
+}
+
+func (b *BerthService) Update(
+	ctx context.Context,
+	id int64,
+	title *string,
+	length *float64,
+	maxDraft *float64,
+	allowedVesselTypes *[]string,
+) error {
+	const op = opStart + ".Update"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := b.bProvider.UpdateBerth(
+		ctx,
+		id,
+		title,
+		length,
+		maxDraft,
+		allowedVesselTypes,
+	); err != nil {
+		log.Error("failed to update berth", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth updated")
+	return nil
+}
+
+func (b *BerthService) Delete(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = opStart + ".Delete"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := b.bProvider.DeleteBerth(ctx, id); err != nil {
+		log.Error("failed to delete berth", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth deleted")
+	return nil
+}
@@ -0,0 +1,188 @@
+package berthscheduleservice
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	opStart = "services.berthschedule"
+)
+
+type BerthScheduleService struct {
+	log *slog.Logger
+	baProvider BerthAllocationProvider
+}
+
+type BerthAllocationProvider interface {
+	BerthAllocations(
+		ctx context.Context,
+		filter models.BerthAllocationFilter,
+		afterID int64,
+		limit int,
+	) ([]models.BerthAllocation, error)
+	CountBerthAllocations(
+		ctx context.Context,
+		filter models.BerthAllocationFilter,
+	) (int64, error)
+	BerthAllocation(ctx context.Context, id int64) (models.BerthAllocation, error)
+	SaveBerthAllocation(ctx context.Context, allocation models.BerthAllocation) (int64, error)
+	DeleteBerthAllocation(ctx context.Context, id int64) error
+	PortCall(ctx context.Context, id int64) (models.PortCall, error)
+}
+
+func New(
+	log *slog.Logger,
+	baProvider BerthAllocationProvider,
+) *BerthScheduleService {
+	return &BerthScheduleService{
+		log: log,
+		baProvider: baProvider,
+	}
+}
+
+func (b *BerthScheduleService) List(
+	ctx context.Context,
+	filter models.BerthAllocationFilter,
+	page models.PageRequest,
+) (models.Page[models.BerthAllocation], error) {
+	const op = opStart + ".List"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op))
+	log.Info("Listing berth allocations")
+
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return models.Page[models.BerthAllocation]{}, fmt.Errorf("%s: to is before from", op)
+	}
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.BerthAllocation]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	allocations, err := b.baProvider.BerthAllocations(ctx, filter, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to list berth allocations", sl.Err(err))
+		return models.Page[models.BerthAllocation]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.BerthAllocation]
+	result.Items, result.NextPageToken = pagination.Trim(allocations, limit,
+		func(a models.BerthAllocation) []int64 { return []int64{a.ID} })
+
+	if page.WithTotal {
+		total, err := b.baProvider.CountBerthAllocations(ctx, filter)
+		if err != nil {
+			log.Error("failed to count berth allocations", sl.Err(err))
+			return models.Page[models.BerthAllocation]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
+
+func (b *BerthScheduleService) Get(
+	ctx context.Context,
+	id int64,
+) (models.BerthAllocation, error) {
+	const op = opStart + ".Get"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return models.BerthAllocation{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	allocation, err := b.baProvider.BerthAllocation(ctx, id)
+	if err != nil {
+		log.Error("failed to get berth allocation", sl.Err(err))
+		return models.BerthAllocation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth allocation received")
+	return allocation, nil
+}
+
+// Allocate reserves a berth for a vessel's stay. When the allocation
+// refers to a port call, the missing vessel and time window are taken
+// from that call.
+func (b *BerthScheduleService) Allocate(
+	ctx context.Context,
+	allocation models.BerthAllocation,
+) (int64, error) {
+	const op = opStart + ".Allocate"
+
+	log := sl.FromContext(ctx, b.log).With(
+		slog.String("op", op),
+		slog.Int64("berthID", allocation.BerthID),
+		slog.Int64("vesselID", allocation.VesselID),
+	)
+
+	if allocation.BerthID <= 0 {
+		return 0, fmt.Errorf("%s: berthID is required", op)
+	}
+
+	if allocation.PortCallID != nil {
+		portCall, err := b.baProvider.PortCall(ctx, *allocation.PortCallID)
+		if err != nil {
+			log.Error("failed to get port call", sl.Err(err))
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if allocation.VesselID == 0 {
+			allocation.VesselID = portCall.VesselID
+		}
+		if allocation.StartsAt.IsZero() {
+			allocation.StartsAt = portCall.ETA
+		}
+		if allocation.EndsAt.IsZero() {
+			allocation.EndsAt = portCall.ETD
+		}
+	}
+
+	if allocation.VesselID <= 0 {
+		return 0, fmt.Errorf("%s: vesselID is required", op)
+	}
+	if allocation.StartsAt.IsZero() || allocation.EndsAt.IsZero() {
+		return 0, fmt.Errorf("%s: time window is required", op)
+	}
+	if !allocation.EndsAt.After(allocation.StartsAt) {
+		return 0, fmt.Errorf("%s: endsAt must be after startsAt", op)
+	}
+
+	id, err := b.baProvider.SaveBerthAllocation(ctx, allocation)
+	if err != nil {
+		log.Error("failed to allocate berth", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth allocated", slog.Int64("id", id))
+	return id, nil
+}
+
+func (b *BerthScheduleService) Cancel(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = opStart + ".Cancel"
+
+	log := sl.FromContext(ctx, b.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := b.baProvider.DeleteBerthAllocation(ctx, id); err != nil {
+		log.Error("failed to cancel berth allocation", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Berth allocation cancelled")
+	return nil
+}
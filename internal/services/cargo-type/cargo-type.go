@@ -4,6 +4,7 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
 	"fmt"
 	"log/slog"
 )
@@ -18,15 +19,18 @@ type CargoTypeService struct {
 }
 
 type CargoTypeProvider interface {
-	CargoTypes(ctx context.Context) ([]models.CargoType, error)
+	CargoTypes(ctx context.Context, afterID int64, limit int, includeArchived bool) ([]models.CargoType, error)
+	CountCargoTypes(ctx context.Context, includeArchived bool) (int64, error)
 	SaveCargoType(ctx context.Context, cargoType models.CargoType) (int64, error)
 	DeleteCargoType(ctx context.Context, id int64) error
-	CargoType(ctx context.Context, id int64) (models.CargoType, error)
+	RestoreCargoType(ctx context.Context, id int64) error
+	CargoType(ctx context.Context, id int64, includeArchived bool) (models.CargoType, error)
 	UpdateCargoType(
 		ctx context.Context,
 		id int64,
 		title *string,
 		processCost *float64,
+		expectedVersion *int64,
 	) error
 }
 
@@ -40,31 +44,58 @@ func New(
 	}
 }
 
-func (c *CargoTypeService) List(ctx context.Context) ([]models.CargoType, error) {
+func (c *CargoTypeService) List(
+	ctx context.Context,
+	page models.PageRequest,
+	includeArchived bool,
+) (models.Page[models.CargoType], error) {
 	const op = opStart + ".List"
 
-	log := c.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op))
 	log.Info("Listing cargo types")
 
-	types, err := c.ctProvider.CargoTypes(ctx)
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.CargoType]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	types, err := c.ctProvider.CargoTypes(ctx, cursor[0], limit+1, includeArchived)
 	if err != nil {
 		log.Error("failed to list cargo types", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return models.Page[models.CargoType]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.CargoType]
+	result.Items, result.NextPageToken = pagination.Trim(types, limit,
+		func(ct models.CargoType) []int64 { return []int64{ct.ID} })
+
+	if page.WithTotal {
+		total, err := c.ctProvider.CountCargoTypes(ctx, includeArchived)
+		if err != nil {
+			log.Error("failed to count cargo types", sl.Err(err))
+			return models.Page[models.CargoType]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
 	}
 
-	return types, nil
+	return result, nil
 }
 
-func (c *CargoTypeService) Get(ctx context.Context, id int64) (models.CargoType, error) {
+func (c *CargoTypeService) Get(
+	ctx context.Context,
+	id int64,
+	includeArchived bool,
+) (models.CargoType, error) {
 	const op = opStart + ".Get"
 
-	log := c.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return models.CargoType{}, fmt.Errorf("%s: invalid id", op)
 	}
 
-	ct, err := c.ctProvider.CargoType(ctx, id)
+	ct, err := c.ctProvider.CargoType(ctx, id, includeArchived)
 	if err != nil {
 		log.Error("failed to get cargo type", sl.Err(err))
 		return models.CargoType{}, fmt.Errorf("%s: %w", op, err)
@@ -77,7 +108,7 @@ func (c *CargoTypeService) Get(ctx context.Context, id int64) (models.CargoType,
 func (c *CargoTypeService) Create(ctx context.Context, cargoType models.CargoType) (int64, error) {
 	const op = opStart + ".Create"
 
-	log := c.log.With(slog.String("op", op), slog.String("title", cargoType.Title))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.String("title", cargoType.Title))
 
 	if cargoType.Title == "" {
 		return 0, fmt.Errorf("%s: title is required", op)
@@ -99,7 +130,7 @@ func (c *CargoTypeService) Create(ctx context.Context, cargoType models.CargoTyp
 func (c *CargoTypeService) Delete(ctx context.Context, id int64) error {
 	const op = opStart + ".Delete"
 
-	log := c.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
@@ -115,21 +146,41 @@ func (c *CargoTypeService) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+func (c *CargoTypeService) Restore(ctx context.Context, id int64) error {
+	const op = opStart + ".Restore"
+
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	err := c.ctProvider.RestoreCargoType(ctx, id)
+	if err != nil {
+		log.Error("failed to restore cargo type", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Cargo type restored")
+	return nil
+}
+
 func (c *CargoTypeService) Update(
 	ctx context.Context,
 	id int64,
 	title *string,
 	processCost *float64,
+	expectedVersion *int64,
 ) error {
 	const op = opStart + ".Update"
 
-	log := c.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
 	}
 
-	err := c.ctProvider.UpdateCargoType(ctx, id, title, processCost)
+	err := c.ctProvider.UpdateCargoType(ctx, id, title, processCost, expectedVersion)
 	if err != nil {
 		log.Error("failed to update cargo type", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
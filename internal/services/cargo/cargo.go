@@ -4,8 +4,12 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
+	"dbcp/internal/storage"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 )
 
 const (
@@ -18,10 +22,19 @@ type CargoService struct {
 }
 
 type CargoProvider interface {
-	Cargos(ctx context.Context) ([]models.Cargo, error)
+	Cargos(ctx context.Context, afterID int64, limit int, includeArchived bool) ([]models.Cargo, error)
+	CountCargos(ctx context.Context, includeArchived bool) (int64, error)
+	SearchCargos(
+		ctx context.Context,
+		filter models.CargoFilter,
+		offset int,
+		limit int,
+	) ([]models.Cargo, error)
+	CountSearchCargos(ctx context.Context, filter models.CargoFilter) (int64, error)
 	SaveCargo(ctx context.Context, cargo models.Cargo) (int64, error)
 	DeleteCargo(ctx context.Context, id int64) error
-	Cargo(ctx context.Context, id int64) (models.Cargo, error)
+	RestoreCargo(ctx context.Context, id int64) error
+	Cargo(ctx context.Context, id int64, includeArchived bool) (models.Cargo, error)
 	UpdateCargo(
 		ctx context.Context,
 		id int64,
@@ -30,7 +43,24 @@ type CargoProvider interface {
 		weight *float64,
 		volume *float64,
 		vesselID *int64,
+		portCallID *int64,
+		expectedVersion *int64,
+	) error
+	SetCargoStatus(
+		ctx context.Context,
+		id int64,
+		from []models.CargoStatus,
+		to models.CargoStatus,
+		operation models.Operation,
 	) error
+	StoragePlacements(
+		ctx context.Context,
+		storageLocID *int64,
+		cargoID *int64,
+		afterID int64,
+		limit int,
+	) ([]models.StoragePlacement, error)
+	CountStoragePlacements(ctx context.Context, storageLocID *int64, cargoID *int64) (int64, error)
 }
 
 func New(
@@ -45,34 +75,125 @@ func New(
 
 func (c *CargoService) List(
 	ctx context.Context,
-) ([]models.Cargo, error) {
+	page models.PageRequest,
+	includeArchived bool,
+) (models.Page[models.Cargo], error) {
 	const op = opStart + ".List"
 
-	log := c.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op))
 	log.Info("Listing cargos")
 
-	cargos, err := c.cProvider.Cargos(ctx)
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	cargos, err := c.cProvider.Cargos(ctx, cursor[0], limit+1, includeArchived)
 	if err != nil {
 		log.Error("failed to list cargos", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.Cargo]
+	result.Items, result.NextPageToken = pagination.Trim(cargos, limit,
+		func(c models.Cargo) []int64 { return []int64{c.ID} })
+
+	if page.WithTotal {
+		total, err := c.cProvider.CountCargos(ctx, includeArchived)
+		if err != nil {
+			log.Error("failed to count cargos", sl.Err(err))
+			return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
+
+func (c *CargoService) Search(
+	ctx context.Context,
+	filter models.CargoFilter,
+	page models.PageRequest,
+) (models.Page[models.Cargo], error) {
+	const op = opStart + ".Search"
+
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op))
+	log.Info("Searching cargos")
+
+	if filter.MinWeight != nil && filter.MaxWeight != nil && *filter.MinWeight > *filter.MaxWeight {
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w: minWeight is greater than maxWeight", op, storage.ErrInvalidFilter)
+	}
+	if filter.MinVolume != nil && filter.MaxVolume != nil && *filter.MinVolume > *filter.MaxVolume {
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w: minVolume is greater than maxVolume", op, storage.ErrInvalidFilter)
+	}
+	if filter.Status != nil && !ValidStatus(*filter.Status) {
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w: unknown status %q", op, storage.ErrInvalidFilter, *filter.Status)
+	}
+
+	// Arbitrary sort orders have no stable keyset, so the search token
+	// carries the offset of the next page instead of an id. It is bound
+	// to the filter and sort order, so that it cannot be replayed on a
+	// different search.
+	scope, err := searchScope(filter)
+	if err != nil {
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	offset, err := pagination.DecodeOffsetToken(page.Token, scope)
+	if err != nil {
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	cargos, err := c.cProvider.SearchCargos(ctx, filter, int(offset), limit+1)
+	if err != nil {
+		log.Error("failed to search cargos", sl.Err(err))
+		return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.Cargo]
+	result.Items = cargos
+	if len(cargos) > limit {
+		result.Items = cargos[:limit]
+		result.NextPageToken = pagination.EncodeOffsetToken(scope, offset+int64(limit))
+	}
+
+	if page.WithTotal {
+		total, err := c.cProvider.CountSearchCargos(ctx, filter)
+		if err != nil {
+			log.Error("failed to count found cargos", sl.Err(err))
+			return models.Page[models.Cargo]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
 	}
 
-	return cargos, nil
+	return result, nil
+}
+
+// searchScope identifies a search by everything that decides which rows
+// it returns and in which order.
+func searchScope(filter models.CargoFilter) (string, error) {
+	raw, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
 }
 
 func (c *CargoService) Get(
 	ctx context.Context, 
 	id int64,
+	includeArchived bool,
 ) (models.Cargo, error) {
 	const op = opStart + ".Get"
 
-	log := c.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return models.Cargo{}, fmt.Errorf("%s: invalid id", op)
 	}
 
-	cargo, err := c.cProvider.Cargo(ctx, id)
+	cargo, err := c.cProvider.Cargo(ctx, id, includeArchived)
 	if err != nil {
 		log.Error("failed to get cargo", sl.Err(err))
 		return models.Cargo{}, fmt.Errorf("%s: %w", op, err)
@@ -88,7 +209,7 @@ func (c *CargoService) Create(
 ) (int64, error) {
 	const op = opStart + ".Create"
 
-	log := c.log.With(
+	log := sl.FromContext(ctx, c.log).With(
 		slog.String("op", op),
 		slog.String("title", cargo.Title),
 	)
@@ -119,7 +240,7 @@ func (c *CargoService) Delete(
 ) error {
 	const op = opStart + ".Delete"
 
-	log := c.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
@@ -134,6 +255,27 @@ func (c *CargoService) Delete(
 	return nil
 }
 
+func (c *CargoService) Restore(
+	ctx context.Context, 
+	id int64,
+) error {
+	const op = opStart + ".Restore"
+
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := c.cProvider.RestoreCargo(ctx, id); err != nil {
+		log.Error("failed to restore cargo", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Cargo restored")
+	return nil
+}
+
 func (c *CargoService) Update(
 	ctx context.Context,
 	id int64,
@@ -142,10 +284,12 @@ func (c *CargoService) Update(
 	weight *float64,
 	volume *float64,
 	vesselID *int64,
+	portCallID *int64,
+	expectedVersion *int64,
 ) error {
 	const op = opStart + ".Update"
 
-	log := c.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
@@ -159,6 +303,8 @@ func (c *CargoService) Update(
 		weight,
 		volume,
 		vesselID,
+		portCallID,
+		expectedVersion,
 	); err != nil {
 		log.Error("failed to update cargo", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
@@ -167,3 +313,91 @@ func (c *CargoService) Update(
 	log.Info("Cargo updated")
 	return nil
 }
+
+// Transition moves a cargo to the given status if the lifecycle allows it
+// and records the move as an operation. IN_STORAGE and RELEASED are driven
+// by StorageLocationService.Use and Reset.
+func (c *CargoService) Transition(
+	ctx context.Context,
+	id int64,
+	to models.CargoStatus,
+	at time.Time,
+) error {
+	const op = opStart + ".Transition"
+
+	log := sl.FromContext(ctx, c.log).With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+		slog.String("to", string(to)),
+	)
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+	if !ValidStatus(to) {
+		return fmt.Errorf("%s: unknown status %q", op, to)
+	}
+	if managedByStorage(to) {
+		return fmt.Errorf("%s: status %q is set by storage location placement", op, to)
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	if err := c.cProvider.SetCargoStatus(
+		ctx,
+		id,
+		AllowedFrom(to),
+		to,
+		TransitionOperation(to, at),
+	); err != nil {
+		log.Error("failed to change cargo status", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Cargo status changed")
+	return nil
+}
+
+// PlacementHistory returns every storage location the cargo has been
+// placed in, oldest placement first.
+func (c *CargoService) PlacementHistory(
+	ctx context.Context,
+	id int64,
+	page models.PageRequest,
+) (models.Page[models.StoragePlacement], error) {
+	const op = opStart + ".PlacementHistory"
+
+	log := sl.FromContext(ctx, c.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	placements, err := c.cProvider.StoragePlacements(ctx, nil, &id, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to list placement history", sl.Err(err))
+		return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.StoragePlacement]
+	result.Items, result.NextPageToken = pagination.Trim(placements, limit,
+		func(p models.StoragePlacement) []int64 { return []int64{p.ID} })
+
+	if page.WithTotal {
+		total, err := c.cProvider.CountStoragePlacements(ctx, nil, &id)
+		if err != nil {
+			log.Error("failed to count placement history", sl.Err(err))
+			return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package cargoservice
+
+import (
+	"dbcp/internal/domain/models"
+	"time"
+)
+
+// transitions lists, for every cargo status, the statuses it may move to.
+var transitions = map[models.CargoStatus][]models.CargoStatus{
+	models.CargoExpected:  {models.CargoOnVessel},
+	models.CargoOnVessel:  {models.CargoUnloaded},
+	models.CargoUnloaded:  {models.CargoInStorage, models.CargoLoaded},
+	models.CargoInStorage: {models.CargoReleased},
+	models.CargoReleased:  {models.CargoInStorage, models.CargoLoaded},
+	models.CargoLoaded:    {models.CargoDeparted},
+	models.CargoDeparted:  {},
+}
+
+// transitionOperations describes the operation recorded when a cargo
+// enters a status.
+var transitionOperations = map[models.CargoStatus]models.Operation{
+	models.CargoOnVessel:  {Title: "Прибытие", Kind: models.OperationArrival},
+	models.CargoUnloaded:  {Title: "Выгрузка", Kind: models.OperationUnloading},
+	models.CargoInStorage: {Title: "Размещение на складе", Kind: models.OperationPlacement},
+	models.CargoReleased:  {Title: "Выдача со склада", Kind: models.OperationRelease},
+	models.CargoLoaded:    {Title: "Погрузка", Kind: models.OperationLoading},
+	models.CargoDeparted:  {Title: "Отправление", Kind: models.OperationDeparture},
+}
+
+// ValidStatus reports whether status is one of the known cargo statuses.
+func ValidStatus(status models.CargoStatus) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+// CanTransition reports whether a cargo may move from one status to another.
+func CanTransition(from, to models.CargoStatus) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedFrom returns the statuses a cargo may be in to move to the given one.
+// The storage layer uses it to guard the status update atomically.
+func AllowedFrom(to models.CargoStatus) []models.CargoStatus {
+	var from []models.CargoStatus
+	for s, next := range transitions {
+		for _, n := range next {
+			if n == to {
+				from = append(from, s)
+			}
+		}
+	}
+	return from
+}
+
+// TransitionOperation returns the operation that records a move to
+// the given status at the given time.
+func TransitionOperation(to models.CargoStatus, at time.Time) models.Operation {
+	o := transitionOperations[to]
+	o.CreatedAt = at
+	return o
+}
+
+// managedByStorage reports whether the status is only reachable through
+// StorageLocationService, since it needs a storage location.
+func managedByStorage(status models.CargoStatus) bool {
+	return status == models.CargoInStorage || status == models.CargoReleased
+}
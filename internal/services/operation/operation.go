@@ -4,6 +4,7 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
 	"fmt"
 	"log/slog"
 )
@@ -16,7 +17,8 @@ type OperationService struct {
 }
 
 type OperationProvider interface {
-	Operations(ctx context.Context) ([]models.Operation, error)
+	Operations(ctx context.Context, afterID int64, limit int) ([]models.Operation, error)
+	CountOperations(ctx context.Context) (int64, error)
 	SaveOperation(ctx context.Context, operation models.Operation) (int64, error)
 	DeleteOperation(ctx context.Context, id int64) error
 	Operation(ctx context.Context, id int64) (models.Operation, error)
@@ -24,6 +26,8 @@ type OperationProvider interface {
 		ctx context.Context,
 		id int64,
 		title *string,
+		kind *models.OperationKind,
+		expectedVersion *int64,
 	) error
 }
 
@@ -37,25 +41,47 @@ func New(
 	}
 }
 
-func (o *OperationService) List(ctx context.Context) ([]models.Operation, error) {
+func (o *OperationService) List(
+	ctx context.Context,
+	page models.PageRequest,
+) (models.Page[models.Operation], error) {
 	const op = opStart + ".List"
 
-	log := o.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, o.log).With(slog.String("op", op))
 	log.Info("Listing operations")
 
-	ops, err := o.oProvider.Operations(ctx)
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.Operation]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	ops, err := o.oProvider.Operations(ctx, cursor[0], limit+1)
 	if err != nil {
 		log.Error("failed to list operations", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return models.Page[models.Operation]{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return ops, nil
+	var result models.Page[models.Operation]
+	result.Items, result.NextPageToken = pagination.Trim(ops, limit,
+		func(o models.Operation) []int64 { return []int64{o.ID} })
+
+	if page.WithTotal {
+		total, err := o.oProvider.CountOperations(ctx)
+		if err != nil {
+			log.Error("failed to count operations", sl.Err(err))
+			return models.Page[models.Operation]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
 }
 
 func (o *OperationService) Get(ctx context.Context, id int64) (models.Operation, error) {
 	const op = opStart + ".Get"
 
-	log := o.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, o.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return models.Operation{}, fmt.Errorf("%s: invalid id", op)
@@ -71,16 +97,27 @@ func (o *OperationService) Get(ctx context.Context, id int64) (models.Operation,
 	return opModel, nil
 }
 
-func (o *OperationService) Create(ctx context.Context, title string) (int64, error) {
+func (o *OperationService) Create(
+	ctx context.Context,
+	title string,
+	kind models.OperationKind,
+) (int64, error) {
 	const op = opStart + ".Create"
 
-	log := o.log.With(slog.String("op", op), slog.String("title", title))
+	log := sl.FromContext(ctx, o.log).With(
+		slog.String("op", op),
+		slog.String("title", title),
+		slog.String("kind", string(kind)),
+	)
 
 	if title == "" {
 		return 0, fmt.Errorf("%s: title is required", op)
 	}
+	if kind == "" {
+		kind = models.OperationOther
+	}
 
-	id, err := o.oProvider.SaveOperation(ctx, models.Operation{Title: title})
+	id, err := o.oProvider.SaveOperation(ctx, models.Operation{Title: title, Kind: kind})
 	if err != nil {
 		log.Error("failed to create operation", sl.Err(err))
 		return 0, fmt.Errorf("%s: %w", op, err)
@@ -93,7 +130,7 @@ func (o *OperationService) Create(ctx context.Context, title string) (int64, err
 func (o *OperationService) Delete(ctx context.Context, id int64) error {
 	const op = opStart + ".Delete"
 
-	log := o.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, o.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
@@ -108,16 +145,22 @@ func (o *OperationService) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (o *OperationService) Update(ctx context.Context, id int64, title *string) error {
+func (o *OperationService) Update(
+	ctx context.Context,
+	id int64,
+	title *string,
+	kind *models.OperationKind,
+	expectedVersion *int64,
+) error {
 	const op = opStart + ".Update"
 
-	log := o.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, o.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
 	}
 
-	if err := o.oProvider.UpdateOperation(ctx, id, title); err != nil {
+	if err := o.oProvider.UpdateOperation(ctx, id, title, kind, expectedVersion); err != nil {
 		log.Error("failed to update operation", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
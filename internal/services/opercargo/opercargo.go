@@ -4,6 +4,7 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
 	"dbcp/internal/storage"
 	"fmt"
 	"log/slog"
@@ -19,7 +20,13 @@ type OperationCargoService struct {
 }
 
 type OperationCargoProvider interface {
-	OperationsCargos(ctx context.Context) ([]models.OperationCargo, error)
+	OperationsCargos(
+		ctx context.Context,
+		afterOperationID int64,
+		afterCargoID int64,
+		limit int,
+	) ([]models.OperationCargo, error)
+	CountOperationsCargos(ctx context.Context) (int64, error)
 	SaveOperationCargo(ctx context.Context, operCargo models.OperationCargo) error
 	DeleteOperationCargo(ctx context.Context, operCargo models.OperationCargo) error
 }
@@ -36,19 +43,39 @@ func New(
 
 func (s *OperationCargoService) List(
 	ctx context.Context,
-) ([]models.OperationCargo, error) {
+	page models.PageRequest,
+) (models.Page[models.OperationCargo], error) {
 	const op = opStart + ".List"
 
-	log := s.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, s.log).With(slog.String("op", op))
 	log.Info("Listing operation cargos")
 
-	opsCargos, err := s.ocProvider.OperationsCargos(ctx)
+	cursor, err := pagination.DecodeToken(page.Token, 2)
+	if err != nil {
+		return models.Page[models.OperationCargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	opsCargos, err := s.ocProvider.OperationsCargos(ctx, cursor[0], cursor[1], limit+1)
 	if err != nil {
 		log.Error("failed to list operation cargos", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return models.Page[models.OperationCargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.OperationCargo]
+	result.Items, result.NextPageToken = pagination.Trim(opsCargos, limit,
+		func(oc models.OperationCargo) []int64 { return []int64{oc.OperationID, oc.CargoID} })
+
+	if page.WithTotal {
+		total, err := s.ocProvider.CountOperationsCargos(ctx)
+		if err != nil {
+			log.Error("failed to count operation cargos", sl.Err(err))
+			return models.Page[models.OperationCargo]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
 	}
 
-	return opsCargos, nil
+	return result, nil
 }
 
 func (s *OperationCargoService) Create(
@@ -57,7 +84,7 @@ func (s *OperationCargoService) Create(
 ) error {
 	const op = opStart + ".Create"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op), 
 		slog.Int64("operation_id", operID), 
 		slog.Int64("cargo_id", cargoID),
@@ -94,7 +121,7 @@ func (s *OperationCargoService) Delete(
 ) error {
 	const op = opStart + ".Delete"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("operation_id", operID), 
 		slog.Int64("cargo_id", cargoID),
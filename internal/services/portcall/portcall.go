@@ -0,0 +1,289 @@
+package portcallservice
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const (
+	opStart = "services.portcall"
+)
+
+type PortCallService struct {
+	log *slog.Logger
+	pcProvider PortCallProvider
+}
+
+type PortCallProvider interface {
+	PortCalls(
+		ctx context.Context,
+		vesselID *int64,
+		afterID int64,
+		limit int,
+	) ([]models.PortCall, error)
+	CountPortCalls(ctx context.Context, vesselID *int64) (int64, error)
+	SavePortCall(ctx context.Context, portCall models.PortCall) (int64, error)
+	PortCall(ctx context.Context, id int64) (models.PortCall, error)
+	UpdatePortCall(
+		ctx context.Context,
+		id int64,
+		voyageNumber *string,
+		eta *time.Time,
+		etd *time.Time,
+	) error
+	DeletePortCall(ctx context.Context, id int64) error
+	SetPortCallStatus(
+		ctx context.Context,
+		id int64,
+		from []models.PortCallStatus,
+		to models.PortCallStatus,
+		ata *time.Time,
+		atd *time.Time,
+	) error
+}
+
+func New(
+	log *slog.Logger,
+	pcProvider PortCallProvider,
+) *PortCallService {
+	return &PortCallService{
+		log: log,
+		pcProvider: pcProvider,
+	}
+}
+
+func (p *PortCallService) List(
+	ctx context.Context,
+	vesselID *int64,
+	page models.PageRequest,
+) (models.Page[models.PortCall], error) {
+	const op = opStart + ".List"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op))
+	log.Info("Listing port calls")
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.PortCall]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	portCalls, err := p.pcProvider.PortCalls(ctx, vesselID, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to list port calls", sl.Err(err))
+		return models.Page[models.PortCall]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.PortCall]
+	result.Items, result.NextPageToken = pagination.Trim(portCalls, limit,
+		func(pc models.PortCall) []int64 { return []int64{pc.ID} })
+
+	if page.WithTotal {
+		total, err := p.pcProvider.CountPortCalls(ctx, vesselID)
+		if err != nil {
+			log.Error("failed to count port calls", sl.Err(err))
+			return models.Page[models.PortCall]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
+
+func (p *PortCallService) Get(
+	ctx context.Context,
+	id int64,
+) (models.PortCall, error) {
+	const op = opStart + ".Get"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return models.PortCall{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	portCall, err := p.pcProvider.PortCall(ctx, id)
+	if err != nil {
+		log.Error("failed to get port call", sl.Err(err))
+		return models.PortCall{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call received")
+	return portCall, nil
+}
+
+func (p *PortCallService) Create(
+	ctx context.Context,
+	portCall models.PortCall,
+) (int64, error) {
+	const op = opStart + ".Create"
+
+	log := sl.FromContext(ctx, p.log).With(
+		slog.String("op", op),
+		slog.Int64("vesselID", portCall.VesselID),
+		slog.String("voyageNumber", portCall.VoyageNumber),
+	)
+
+	if portCall.VesselID <= 0 {
+		return 0, fmt.Errorf("%s: vesselID is required", op)
+	}
+	if portCall.VoyageNumber == "" {
+		return 0, fmt.Errorf("%s: voyageNumber is required", op)
+	}
+	if portCall.ETD.Before(portCall.ETA) {
+		return 0, fmt.Errorf("%s: etd is before eta", op)
+	}
+
+	id, err := p.pcProvider.SavePortCall(ctx, portCall)
+	if err != nil {
+		log.Error("failed to create port call", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call created", slog.Int64("id", id))
+	return id, nil
+}
+
+func (p *PortCallService) Update(
+	ctx context.Context,
+	id int64,
+	voyageNumber *string,
+	eta *time.Time,
+	etd *time.Time,
+) error {
+	const op = opStart + ".Update"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := p.pcProvider.UpdatePortCall(ctx, id, voyageNumber, eta, etd); err != nil {
+		log.Error("failed to update port call", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call updated")
+	return nil
+}
+
+func (p *PortCallService) Delete(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = opStart + ".Delete"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := p.pcProvider.DeletePortCall(ctx, id); err != nil {
+		log.Error("failed to delete port call", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call deleted")
+	return nil
+}
+
+// Arrive records the actual time of arrival of a scheduled call.
+func (p *PortCallService) Arrive(
+	ctx context.Context,
+	id int64,
+	ata time.Time,
+) error {
+	const op = opStart + ".Arrive"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+	if ata.IsZero() {
+		ata = time.Now()
+	}
+
+	if err := p.pcProvider.SetPortCallStatus(
+		ctx,
+		id,
+		[]models.PortCallStatus{models.PortCallScheduled},
+		models.PortCallArrived,
+		&ata,
+		nil,
+	); err != nil {
+		log.Error("failed to record arrival", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call arrived")
+	return nil
+}
+
+// Depart records the actual time of departure of a vessel in port.
+func (p *PortCallService) Depart(
+	ctx context.Context,
+	id int64,
+	atd time.Time,
+) error {
+	const op = opStart + ".Depart"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+	if atd.IsZero() {
+		atd = time.Now()
+	}
+
+	if err := p.pcProvider.SetPortCallStatus(
+		ctx,
+		id,
+		[]models.PortCallStatus{models.PortCallArrived},
+		models.PortCallDeparted,
+		nil,
+		&atd,
+	); err != nil {
+		log.Error("failed to record departure", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call departed")
+	return nil
+}
+
+func (p *PortCallService) Cancel(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = opStart + ".Cancel"
+
+	log := sl.FromContext(ctx, p.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	if err := p.pcProvider.SetPortCallStatus(
+		ctx,
+		id,
+		[]models.PortCallStatus{models.PortCallScheduled},
+		models.PortCallCancelled,
+		nil,
+		nil,
+	); err != nil {
+		log.Error("failed to cancel port call", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Port call cancelled")
+	return nil
+}
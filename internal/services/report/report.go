@@ -33,7 +33,7 @@ func (s *ReportService) CargoDetailReport(
 ) ([]models.CargoDetailItem, error) {
 	const op = "services.report.CargoDetailReport"
 
-	log := s.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, s.log).With(slog.String("op", op))
 	log.Info("Generating report \"Cargo detail\"")
 
 	cargoItems, err := s.rProvider.CargoDetailReport(ctx)
@@ -50,7 +50,7 @@ func (s *ReportService) CargoTypeReport(
 ) ([]models.CargoTypeItem, error) {
 	const op = "services.report.CargoDetailReport"
 
-	log := s.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, s.log).With(slog.String("op", op))
 	log.Info("Generating report \"Cargo detail\"")
 
 	cargoTypeItems, err := s.rProvider.CargoTypeReport(ctx)
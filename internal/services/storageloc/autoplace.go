@@ -0,0 +1,72 @@
+package storagelocservice
+
+import (
+	"dbcp/internal/domain/models"
+	"dbcp/internal/storage"
+	"sort"
+)
+
+// planPlacement assigns cargos to storage locations with a best fit
+// decreasing heuristic: the heaviest cargos are placed first, each into
+// the location of its type that it leaves with the least spare capacity.
+// Cargos that fit nowhere are reported with the reason.
+func planPlacement(
+	cargos []models.Cargo,
+	locs []models.StorageLocation,
+) models.AutoPlacementResult {
+	byType := make(map[int64][]models.StorageLocation)
+	for _, loc := range locs {
+		byType[loc.CargoTypeID] = append(byType[loc.CargoTypeID], loc)
+	}
+
+	ordered := make([]models.Cargo, len(cargos))
+	copy(ordered, cargos)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		if a.Volume != b.Volume {
+			return a.Volume > b.Volume
+		}
+		return a.ID < b.ID
+	})
+
+	var result models.AutoPlacementResult
+	for _, cargo := range ordered {
+		typeLocs, ok := byType[cargo.TypeID]
+		if !ok {
+			result.Unplaced = append(result.Unplaced, models.UnplacedCargo{
+				CargoID: cargo.ID,
+				Reason:  storage.ErrStorageLocTypeNotSuitable,
+			})
+			continue
+		}
+
+		candidates := rankCandidates(typeLocs, cargo, models.PlacementBestFit)
+		if len(candidates) == 0 {
+			result.Unplaced = append(result.Unplaced, models.UnplacedCargo{
+				CargoID: cargo.ID,
+				Reason:  storage.ErrStorageLocNotSuitable,
+			})
+			continue
+		}
+
+		chosen := candidates[0].StorageLocation.ID
+		for i := range typeLocs {
+			if typeLocs[i].ID == chosen {
+				typeLocs[i].UsedWeight += cargo.Weight
+				typeLocs[i].UsedVolume += cargo.Volume
+				typeLocs[i].CargoCount++
+				break
+			}
+		}
+
+		result.Placed = append(result.Placed, models.PlacementAssignment{
+			CargoID:      cargo.ID,
+			StorageLocID: chosen,
+		})
+	}
+
+	return result
+}
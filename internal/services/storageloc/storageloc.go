@@ -4,6 +4,8 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
+	cargoservice "dbcp/internal/services/cargo"
 	"fmt"
 	"log/slog"
 	"time"
@@ -19,7 +21,8 @@ type StorageLocService struct {
 }
 
 type StorageLocProvider interface {
-	StorageLocations(ctx context.Context) ([]models.StorageLocation, error)
+	StorageLocations(ctx context.Context, afterID int64, limit int) ([]models.StorageLocation, error)
+	CountStorageLocations(ctx context.Context) (int64, error)
 	SaveStorageLoc(
 		ctx context.Context,
 		cargoTypeID int64,
@@ -34,14 +37,56 @@ type StorageLocProvider interface {
 		cargoTypeID *int64,
 		maxWeight *float64,
 		maxVolume *float64,
+		expectedVersion *int64,
 	) error
 	UseStorageLoc(
 		ctx context.Context,
 		storageLocID int64,
 		cargoID int64,
-		date time.Time,
+		allowedFrom []models.CargoStatus,
+		operation models.Operation,
 	) error
-	ResetStorageLoc(ctx context.Context, id int64) error
+	ResetStorageLoc(
+		ctx context.Context,
+		id int64,
+		cargoID *int64,
+		allowedFrom []models.CargoStatus,
+		operation models.Operation,
+	) error
+	StoragePlacements(
+		ctx context.Context,
+		storageLocID *int64,
+		cargoID *int64,
+		afterID int64,
+		limit int,
+	) ([]models.StoragePlacement, error)
+	CountStoragePlacements(ctx context.Context, storageLocID *int64, cargoID *int64) (int64, error)
+	SuitableStorageLocations(
+		ctx context.Context,
+		cargoTypeID int64,
+		weight float64,
+		volume float64,
+	) ([]models.StorageLocation, error)
+	Cargo(ctx context.Context, id int64, includeArchived bool) (models.Cargo, error)
+	MoveCargo(
+		ctx context.Context,
+		cargoID int64,
+		toStorageLocID int64,
+		operation models.Operation,
+	) (int64, error)
+	AutoPlaceCargos(
+		ctx context.Context,
+		vesselID int64,
+		allowedFrom []models.CargoStatus,
+		plan models.PlacementPlanner,
+		operation models.Operation,
+	) (models.AutoPlacementResult, error)
+	StorageLocationContents(
+		ctx context.Context,
+		id int64,
+		afterPlacementID int64,
+		limit int,
+	) ([]models.StoredCargo, error)
 }
 
 func New(
@@ -56,19 +101,39 @@ func New(
 
 func (s *StorageLocService) List(
 	ctx context.Context,
-) ([]models.StorageLocation, error) {
+	page models.PageRequest,
+) (models.Page[models.StorageLocation], error) {
 	const op = opStart + ".List"
 
-	log := s.log.With(slog.String("op", op))
+	log := sl.FromContext(ctx, s.log).With(slog.String("op", op))
 	log.Info("listing storage locations")
 
-	locs, err := s.slProvider.StorageLocations(ctx)
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.StorageLocation]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	locs, err := s.slProvider.StorageLocations(ctx, cursor[0], limit+1)
 	if err != nil {
 		log.Error("failed to list storage locations", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return models.Page[models.StorageLocation]{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return locs, nil
+	var result models.Page[models.StorageLocation]
+	result.Items, result.NextPageToken = pagination.Trim(locs, limit,
+		func(l models.StorageLocation) []int64 { return []int64{l.ID} })
+
+	if page.WithTotal {
+		total, err := s.slProvider.CountStorageLocations(ctx)
+		if err != nil {
+			log.Error("failed to count storage locations", sl.Err(err))
+			return models.Page[models.StorageLocation]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
 }
 
 
@@ -78,7 +143,7 @@ func (s *StorageLocService) Get(
 ) (models.StorageLocation, error) {
 	const op = opStart + ".Get"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("id", id),
 	)
@@ -105,7 +170,7 @@ func (s *StorageLocService) Create(
 ) (int64, error) {
 	const op = opStart + ".Create"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("cargoTypeID", cargoTypeID),
 	)
@@ -136,7 +201,7 @@ func (s *StorageLocService) Delete(
 ) error {
 	const op = opStart + ".Delete"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("id", id),
 	)
@@ -160,10 +225,11 @@ func (s *StorageLocService) Update(
 	cargoTypeID *int64,
 	maxWeight *float64,
 	maxVolume *float64,
+	expectedVersion *int64,
 ) error {
 	const op = opStart + ".Update"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("id", id),
 	)
@@ -178,6 +244,7 @@ func (s *StorageLocService) Update(
 		cargoTypeID,
 		maxWeight,
 		maxVolume,
+		expectedVersion,
 	); err != nil {
 		log.Error("failed to update storage location", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
@@ -195,7 +262,7 @@ func (s *StorageLocService) Use(
 ) error {
 	const op = opStart + ".Use"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("id", id),
 		slog.Int64("cargoID", cargoID),
@@ -211,7 +278,13 @@ func (s *StorageLocService) Use(
 		date = time.Now()
 	}
 
-	if err := s.slProvider.UseStorageLoc(ctx, id, cargoID, date); err != nil {
+	if err := s.slProvider.UseStorageLoc(
+		ctx,
+		id,
+		cargoID,
+		cargoservice.AllowedFrom(models.CargoInStorage),
+		cargoservice.TransitionOperation(models.CargoInStorage, date),
+	); err != nil {
 		log.Error("failed to use storage location", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -220,13 +293,16 @@ func (s *StorageLocService) Use(
 	return nil
 }
 
+// Reset releases the cargo from the storage location. When cargoID is nil
+// every cargo in the location is released.
 func (s *StorageLocService) Reset(
 	ctx context.Context, 
 	id int64,
+	cargoID *int64,
 ) error {
 	const op = opStart + ".Reset"
 
-	log := s.log.With(
+	log := sl.FromContext(ctx, s.log).With(
 		slog.String("op", op),
 		slog.Int64("id", id),
 	)
@@ -234,12 +310,223 @@ func (s *StorageLocService) Reset(
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
 	}
+	if cargoID != nil && *cargoID <= 0 {
+		return fmt.Errorf("%s: invalid cargo id", op)
+	}
 
-	if err := s.slProvider.ResetStorageLoc(ctx, id); err != nil {
+	if err := s.slProvider.ResetStorageLoc(
+		ctx,
+		id,
+		cargoID,
+		cargoservice.AllowedFrom(models.CargoReleased),
+		cargoservice.TransitionOperation(models.CargoReleased, time.Now()),
+	); err != nil {
 		log.Error("failed to reset storage location", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	log.Info("storage location reset")
 	return nil
+}
+
+// History returns the cargos that have ever been placed in the storage
+// location, oldest placement first.
+func (s *StorageLocService) History(
+	ctx context.Context,
+	id int64,
+	page models.PageRequest,
+) (models.Page[models.StoragePlacement], error) {
+	const op = opStart + ".History"
+
+	log := sl.FromContext(ctx, s.log).With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+
+	if id <= 0 {
+		return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	placements, err := s.slProvider.StoragePlacements(ctx, &id, nil, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to list placement history", sl.Err(err))
+		return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.StoragePlacement]
+	result.Items, result.NextPageToken = pagination.Trim(placements, limit,
+		func(p models.StoragePlacement) []int64 { return []int64{p.ID} })
+
+	if page.WithTotal {
+		total, err := s.slProvider.CountStoragePlacements(ctx, &id, nil)
+		if err != nil {
+			log.Error("failed to count placement history", sl.Err(err))
+			return models.Page[models.StoragePlacement]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
+}
+
+// Contents returns the cargos currently placed in the storage location.
+func (s *StorageLocService) Contents(
+	ctx context.Context,
+	id int64,
+	page models.PageRequest,
+) (models.Page[models.StoredCargo], error) {
+	const op = opStart + ".Contents"
+
+	log := sl.FromContext(ctx, s.log).With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+
+	if id <= 0 {
+		return models.Page[models.StoredCargo]{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.StoredCargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	contents, err := s.slProvider.StorageLocationContents(ctx, id, cursor[0], limit+1)
+	if err != nil {
+		log.Error("failed to list storage location contents", sl.Err(err))
+		return models.Page[models.StoredCargo]{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.Page[models.StoredCargo]
+	result.Items, result.NextPageToken = pagination.Trim(contents, limit,
+		func(c models.StoredCargo) []int64 { return []int64{c.PlacementID} })
+
+	return result, nil
+}
+
+// SuggestPlacement returns the storage locations that can take the cargo,
+// ranked by the strategy and trimmed to limit entries.
+func (s *StorageLocService) SuggestPlacement(
+	ctx context.Context,
+	cargoID int64,
+	strategy models.PlacementStrategy,
+	limit int32,
+) ([]models.PlacementCandidate, error) {
+	const op = opStart + ".SuggestPlacement"
+
+	log := sl.FromContext(ctx, s.log).With(
+		slog.String("op", op),
+		slog.Int64("cargoID", cargoID),
+	)
+
+	if cargoID <= 0 {
+		return nil, fmt.Errorf("%s: invalid cargo id", op)
+	}
+
+	cargo, err := s.slProvider.Cargo(ctx, cargoID, false)
+	if err != nil {
+		log.Error("failed to get cargo", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	locs, err := s.slProvider.SuitableStorageLocations(ctx, cargo.TypeID, cargo.Weight, cargo.Volume)
+	if err != nil {
+		log.Error("failed to list suitable storage locations", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	candidates := rankCandidates(locs, cargo, strategy)
+	if n := pagination.Size(limit); len(candidates) > n {
+		candidates = candidates[:n]
+	}
+
+	log.Info("placement suggested", slog.Int("candidates", len(candidates)))
+	return candidates, nil
+}
+
+// AutoPlaceVessel places every unloaded or released cargo of the vessel
+// that is not in storage yet, all in one transaction.
+func (s *StorageLocService) AutoPlaceVessel(
+	ctx context.Context,
+	vesselID int64,
+	date time.Time,
+) (models.AutoPlacementResult, error) {
+	const op = opStart + ".AutoPlaceVessel"
+
+	log := sl.FromContext(ctx, s.log).With(
+		slog.String("op", op),
+		slog.Int64("vesselID", vesselID),
+	)
+
+	if vesselID <= 0 {
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: invalid vessel id", op)
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	result, err := s.slProvider.AutoPlaceCargos(
+		ctx,
+		vesselID,
+		cargoservice.AllowedFrom(models.CargoInStorage),
+		planPlacement,
+		cargoservice.TransitionOperation(models.CargoInStorage, date),
+	)
+	if err != nil {
+		log.Error("failed to auto place vessel cargo", sl.Err(err))
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("vessel cargo placed",
+		slog.Int("placed", len(result.Placed)),
+		slog.Int("unplaced", len(result.Unplaced)),
+	)
+	return result, nil
+}
+
+// Move transfers a placed cargo to another storage location and returns
+// the id of the location it was taken from.
+func (s *StorageLocService) Move(
+	ctx context.Context,
+	cargoID int64,
+	toID int64,
+	date time.Time,
+) (int64, error) {
+	const op = opStart + ".Move"
+
+	log := sl.FromContext(ctx, s.log).With(
+		slog.String("op", op),
+		slog.Int64("cargoID", cargoID),
+		slog.Int64("toID", toID),
+	)
+
+	if cargoID <= 0 {
+		return 0, fmt.Errorf("%s: invalid cargo id", op)
+	}
+	if toID <= 0 {
+		return 0, fmt.Errorf("%s: invalid storage location id", op)
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	fromID, err := s.slProvider.MoveCargo(ctx, cargoID, toID, models.Operation{
+		Title:     "Перемещение между местами хранения",
+		Kind:      models.OperationTransfer,
+		CreatedAt: date,
+	})
+	if err != nil {
+		log.Error("failed to move cargo", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("cargo moved", slog.Int64("fromID", fromID))
+	return fromID, nil
 }
\ No newline at end of file
@@ -0,0 +1,52 @@
+package storagelocservice
+
+import (
+	"dbcp/internal/domain/models"
+	"sort"
+)
+
+// rankCandidates orders the storage locations able to take the cargo
+// according to the strategy.
+//
+// The score is the share of weight and volume capacity left unused
+// after placing the cargo, so 0 means a perfect fit and 2 an empty
+// location barely touched. Best fit prefers the lowest score, worst fit
+// the highest, and first fit keeps the locations in id order.
+func rankCandidates(
+	locs []models.StorageLocation,
+	cargo models.Cargo,
+	strategy models.PlacementStrategy,
+) []models.PlacementCandidate {
+	candidates := make([]models.PlacementCandidate, 0, len(locs))
+	for _, loc := range locs {
+		remainingWeight := loc.MaxWeight - loc.UsedWeight - cargo.Weight
+		remainingVolume := loc.MaxVolume - loc.UsedVolume - cargo.Volume
+		if remainingWeight < 0 || remainingVolume < 0 {
+			continue
+		}
+
+		candidates = append(candidates, models.PlacementCandidate{
+			StorageLocation: loc,
+			RemainingWeight: remainingWeight,
+			RemainingVolume: remainingVolume,
+			Score:           remainingWeight/loc.MaxWeight + remainingVolume/loc.MaxVolume,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch strategy {
+		case models.PlacementBestFit:
+			if a.Score != b.Score {
+				return a.Score < b.Score
+			}
+		case models.PlacementWorstFit:
+			if a.Score != b.Score {
+				return a.Score > b.Score
+			}
+		}
+		return a.StorageLocation.ID < b.StorageLocation.ID
+	})
+
+	return candidates
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/lib/logger/sl"
+	"dbcp/internal/lib/pagination"
 	"fmt"
 	"log/slog"
 )
@@ -18,16 +19,20 @@ type VesselService struct {
 }
 
 type VesselProvider interface {
-	Vessels(ctx context.Context) ([]models.Vessel, error)
+	Vessels(ctx context.Context, afterID int64, limit int, includeArchived bool) ([]models.Vessel, error)
+	CountVessels(ctx context.Context, includeArchived bool) (int64, error)
 	SaveVessel(ctx context.Context, vessel models.Vessel) (int64, error)
 	DeleteVessel(ctx context.Context, id int64) error
-	Vessel(ctx context.Context, id int64) (models.Vessel, error)
+	RestoreVessel(ctx context.Context, id int64) error
+	VesselLoad(ctx context.Context, id int64) (models.VesselLoad, error)
+	Vessel(ctx context.Context, id int64, includeArchived bool) (models.Vessel, error)
 	UpdateVessel(
 		ctx context.Context,
 		id int64,
 		title *string,
 		vesselType *string,
 		maxLoad *float64,
+		expectedVersion *int64,
 	) error
 }
 
@@ -41,35 +46,63 @@ func New(
 	}
 }
 
-func (v *VesselService) List(ctx context.Context) ([]models.Vessel, error) {
+func (v *VesselService) List(
+	ctx context.Context,
+	page models.PageRequest,
+	includeArchived bool,
+) (models.Page[models.Vessel], error) {
 	const op = opStart + ".List"
 
-	log := v.log.With(
+	log := sl.FromContext(ctx, v.log).With(
 		slog.String("op", op),
 	)
 
 	log.Info("Listing vessels")
 
-	vessels, err := v.vProvider.Vessels(ctx)
+	cursor, err := pagination.DecodeToken(page.Token, 1)
+	if err != nil {
+		return models.Page[models.Vessel]{}, fmt.Errorf("%s: %w", op, err)
+	}
+	limit := pagination.Size(page.Size)
+
+	vessels, err := v.vProvider.Vessels(ctx, cursor[0], limit+1, includeArchived)
 	if err != nil {
 		log.Error("failed to list vessels", sl.Err(err))
 
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return models.Page[models.Vessel]{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return vessels, nil
+	var result models.Page[models.Vessel]
+	result.Items, result.NextPageToken = pagination.Trim(vessels, limit,
+		func(v models.Vessel) []int64 { return []int64{v.ID} })
+
+	if page.WithTotal {
+		total, err := v.vProvider.CountVessels(ctx, includeArchived)
+		if err != nil {
+			log.Error("failed to count vessels", sl.Err(err))
+
+			return models.Page[models.Vessel]{}, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TotalCount = &total
+	}
+
+	return result, nil
 }
 
-func (v *VesselService) Get(ctx context.Context, id int64) (models.Vessel, error) {
+func (v *VesselService) Get(
+	ctx context.Context,
+	id int64,
+	includeArchived bool,
+) (models.Vessel, error) {
 	const op = opStart + ".Get"
 
-	log := v.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, v.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return models.Vessel{}, fmt.Errorf("%s: invalid id", op)
 	}
 
-	vessel, err := v.vProvider.Vessel(ctx, id)
+	vessel, err := v.vProvider.Vessel(ctx, id, includeArchived)
 	if err != nil {
 		log.Error("failed to get vessel", sl.Err(err))
 		return models.Vessel{}, fmt.Errorf("%s: %w", op, err)
@@ -79,10 +112,34 @@ func (v *VesselService) Get(ctx context.Context, id int64) (models.Vessel, error
 	return vessel, nil
 }
 
+func (v *VesselService) GetLoad(ctx context.Context, id int64) (models.VesselLoad, error) {
+	const op = opStart + ".GetLoad"
+
+	log := sl.FromContext(ctx, v.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return models.VesselLoad{}, fmt.Errorf("%s: invalid id", op)
+	}
+
+	load, err := v.vProvider.VesselLoad(ctx, id)
+	if err != nil {
+		log.Error("failed to get vessel load", sl.Err(err))
+		return models.VesselLoad{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	load.RemainingCapacity = max(load.MaxLoad-load.CurrentLoad, 0)
+	if load.MaxLoad > 0 {
+		load.UtilisationPercent = load.CurrentLoad / load.MaxLoad * 100
+	}
+
+	log.Info("Vessel load calculated")
+	return load, nil
+}
+
 func (v *VesselService) Create(ctx context.Context, vessel models.Vessel) (int64, error) {
 	const op = opStart + ".Create"
 
-	log := v.log.With(slog.String("op", op), slog.String("title", vessel.Title))
+	log := sl.FromContext(ctx, v.log).With(slog.String("op", op), slog.String("title", vessel.Title))
 
 	// Валидация
 	if vessel.Title == "" {
@@ -108,7 +165,7 @@ func (v *VesselService) Create(ctx context.Context, vessel models.Vessel) (int64
 func (v *VesselService) Delete(ctx context.Context, id int64) error {
 	const op = opStart + ".Delete"
 
-	log := v.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, v.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
@@ -124,22 +181,42 @@ func (v *VesselService) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+func (v *VesselService) Restore(ctx context.Context, id int64) error {
+	const op = opStart + ".Restore"
+
+	log := sl.FromContext(ctx, v.log).With(slog.String("op", op), slog.Int64("id", id))
+
+	if id <= 0 {
+		return fmt.Errorf("%s: invalid id", op)
+	}
+
+	err := v.vProvider.RestoreVessel(ctx, id)
+	if err != nil {
+		log.Error("failed to restore vessel", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("Vessel restored")
+	return nil
+}
+
 func (v *VesselService) Update(
 	ctx context.Context,
 	id int64,
 	title *string,
 	vesselType *string,
 	maxLoad *float64,
+	expectedVersion *int64,
 ) error {
 	const op = opStart + ".Update"
 
-	log := v.log.With(slog.String("op", op), slog.Int64("id", id))
+	log := sl.FromContext(ctx, v.log).With(slog.String("op", op), slog.Int64("id", id))
 
 	if id <= 0 {
 		return fmt.Errorf("%s: invalid id", op)
 	}
 
-	err := v.vProvider.UpdateVessel(ctx, id, title, vesselType, maxLoad)
+	err := v.vProvider.UpdateVessel(ctx, id, title, vesselType, maxLoad, expectedVersion)
 	if err != nil {
 		log.Error("failed to update vessel", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
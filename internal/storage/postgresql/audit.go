@@ -0,0 +1,194 @@
+package postgresql
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/lib/actor"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// auditSnapshots holds the query that reads an audited entity as JSON and
+// locks its row. Cargo and storage location snapshots carry the current
+// placement, so that Use, Reset and Move are visible in the audit log.
+var auditSnapshots = map[models.AuditEntity]string{
+	models.AuditVessel: `
+		SELECT to_jsonb(t) FROM vessel t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditCargoType: `
+		SELECT to_jsonb(t) FROM cargo_type t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditCargo: `
+		SELECT to_jsonb(t) || jsonb_build_object('storage_loc_id', (
+			SELECT sp.storage_loc_id FROM storage_placement sp
+			WHERE sp.cargo_id = t.id AND sp.released_at IS NULL
+		))
+		FROM cargo t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditOperation: `
+		SELECT to_jsonb(t) FROM operation t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditOperationCargo: `
+		SELECT to_jsonb(t) FROM operation_cargo t
+		WHERE t.operation_id = $1 AND t.cargo_id = $2 FOR UPDATE OF t`,
+	models.AuditStorageLoc: `
+		SELECT to_jsonb(t) || jsonb_build_object('cargo_ids', ARRAY(
+			SELECT sp.cargo_id FROM storage_placement sp
+			WHERE sp.storage_loc_id = t.id AND sp.released_at IS NULL
+			ORDER BY sp.cargo_id
+		))
+		FROM storage_loc t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditPortCall: `
+		SELECT to_jsonb(t) FROM port_call t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditBerth: `
+		SELECT to_jsonb(t) FROM berth t WHERE t.id = $1 FOR UPDATE OF t`,
+	models.AuditBerthAllocation: `
+		SELECT to_jsonb(t) FROM berth_allocation t WHERE t.id = $1 FOR UPDATE OF t`,
+}
+
+// snapshot returns the entity identified by keys as JSON, or nil when it
+// does not exist. Must be called inside a transaction.
+func snapshot(
+	ctx context.Context,
+	tx pgx.Tx,
+	entity models.AuditEntity,
+	keys ...int64,
+) (json.RawMessage, error) {
+	args := make([]any, 0, len(keys))
+	for _, k := range keys {
+		args = append(args, k)
+	}
+
+	var data json.RawMessage
+	err := tx.QueryRow(ctx, auditSnapshots[entity], args...).Scan(&data)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// audit records the change of the entity made by the caller in ctx. The
+// after snapshot is read here, so audit must be called once the change is
+// applied and before the transaction commits.
+func audit(
+	ctx context.Context,
+	tx pgx.Tx,
+	entity models.AuditEntity,
+	action models.AuditAction,
+	before json.RawMessage,
+	keys ...int64,
+) error {
+	after, err := snapshot(ctx, tx, entity, keys...)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ids = append(ids, strconv.FormatInt(k, 10))
+	}
+
+	var claimed *string
+	if name := actor.ClaimedFromContext(ctx); name != "" {
+		claimed = &name
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO audit_log (entity_type, entity_id, action, before, after, actor, claimed_actor)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, entity, strings.Join(ids, ":"), action, before, after, actor.FromContext(ctx), claimed)
+
+	return err
+}
+
+// AuditRecords returns the audit records matching the filter, newest
+// first. beforeID of zero starts from the latest record.
+func (s *Storage) AuditRecords(
+	ctx context.Context,
+	filter models.AuditFilter,
+	beforeID int64,
+	limit int,
+) ([]models.AuditRecord, error) {
+	const op = "storage.postgresql.AuditRecords"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, entity_type, entity_id, action, before, after, actor, claimed_actor, at
+		FROM audit_log
+		WHERE ($1::bigint = 0 OR id < $1)
+			AND ($2::text IS NULL OR entity_type = $2)
+			AND ($3::text IS NULL OR entity_id = $3)
+			AND ($4::text IS NULL OR actor = $4)
+			AND ($5::timestamptz IS NULL OR at >= $5)
+			AND ($6::timestamptz IS NULL OR at < $6)
+		ORDER BY id DESC
+		LIMIT $7
+	`, beforeID,
+		filter.EntityType,
+		filter.EntityID,
+		filter.Actor,
+		filter.From,
+		filter.To,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var records []models.AuditRecord
+	for rows.Next() {
+		var r models.AuditRecord
+		if err := rows.Scan(&r.ID,
+			&r.EntityType,
+			&r.EntityID,
+			&r.Action,
+			&r.Before,
+			&r.After,
+			&r.Actor,
+			&r.ClaimedActor,
+			&r.At,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		records = append(records, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return records, nil
+}
+
+func (s *Storage) CountAuditRecords(
+	ctx context.Context,
+	filter models.AuditFilter,
+) (int64, error) {
+	const op = "storage.postgresql.CountAuditRecords"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM audit_log
+		WHERE ($1::text IS NULL OR entity_type = $1)
+			AND ($2::text IS NULL OR entity_id = $2)
+			AND ($3::text IS NULL OR actor = $3)
+			AND ($4::timestamptz IS NULL OR at >= $4)
+			AND ($5::timestamptz IS NULL OR at < $5)
+	`, filter.EntityType,
+		filter.EntityID,
+		filter.Actor,
+		filter.From,
+		filter.To,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
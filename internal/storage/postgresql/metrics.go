@@ -0,0 +1,114 @@
+package postgresql
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolStat returns a snapshot of the connection pool statistics.
+func (s *Storage) PoolStat() *pgxpool.Stat {
+	return s.pool.Stat()
+}
+
+// StorageOccupancy returns, per cargo type, how many storage locations
+// hold cargo and how many are empty.
+func (s *Storage) StorageOccupancy(
+	ctx context.Context,
+) ([]models.StorageOccupancy, error) {
+	const op = "storage.postgresql.StorageOccupancy"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT sl.cargo_type_id,
+			COUNT(DISTINCT sp.storage_loc_id),
+			COUNT(DISTINCT sl.id) - COUNT(DISTINCT sp.storage_loc_id)
+		FROM storage_loc sl
+		LEFT JOIN storage_placement sp
+			ON sp.storage_loc_id = sl.id AND sp.released_at IS NULL
+		GROUP BY sl.cargo_type_id
+		ORDER BY sl.cargo_type_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var items []models.StorageOccupancy
+	for rows.Next() {
+		var item models.StorageOccupancy
+		if err := rows.Scan(
+			&item.CargoTypeID,
+			&item.Occupied,
+			&item.Free,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// VesselCargoCounts returns the number of cargos of every vessel. Archived
+// vessels and cargos are left out.
+func (s *Storage) VesselCargoCounts(
+	ctx context.Context,
+) ([]models.VesselCargoCount, error) {
+	const op = "storage.postgresql.VesselCargoCounts"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT v.id, COUNT(c.id)
+		FROM vessel v
+		LEFT JOIN cargo c ON c.vessel_id = v.id AND c.deleted_at IS NULL
+		WHERE v.deleted_at IS NULL
+		GROUP BY v.id
+		ORDER BY v.id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var items []models.VesselCargoCount
+	for rows.Next() {
+		var item models.VesselCargoCount
+		if err := rows.Scan(&item.VesselID, &item.CargoCount); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// CountPlacementsSince returns the number of cargo placements made at or
+// after since.
+func (s *Storage) CountPlacementsSince(
+	ctx context.Context,
+	since time.Time,
+) (int64, error) {
+	const op = "storage.postgresql.CountPlacementsSince"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM storage_placement
+		WHERE placed_at >= $1
+	`, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
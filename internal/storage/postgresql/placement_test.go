@@ -0,0 +1,289 @@
+package postgresql
+
+import (
+	"context"
+	"dbcp/internal/domain/models"
+	"dbcp/internal/storage"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testConnStringEnv names the database the integration tests run against.
+// It must be migrated to the latest version; the tests are skipped when
+// it is not set.
+const testConnStringEnv = "DBCP_TEST_DB_CONN"
+
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+
+	connString := os.Getenv(testConnStringEnv)
+	if connString == "" {
+		t.Skipf("%s is not set", testConnStringEnv)
+	}
+
+	s, err := New(context.Background(), connString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// placementFixture owns the rows a placement test creates and removes
+// them, together with their operations and audit records, on cleanup.
+type placementFixture struct {
+	s        *Storage
+	suffix   string
+	typeID   int64
+	vesselID int64
+	cargoIDs []int64
+	locIDs   []int64
+}
+
+func newPlacementFixture(t *testing.T, s *Storage) *placementFixture {
+	t.Helper()
+
+	ctx := context.Background()
+	f := &placementFixture{s: s, suffix: fmt.Sprintf("%d", time.Now().UnixNano())}
+
+	var err error
+	f.typeID, err = s.SaveCargoType(ctx, models.CargoType{Title: "test-type-" + f.suffix, ProcessCost: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.cleanup(t) })
+
+	f.vesselID, err = s.SaveVessel(ctx, models.Vessel{Title: "test-vessel-" + f.suffix, VesselType: "test", MaxLoad: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return f
+}
+
+// addCargo creates an unloaded cargo ready to be placed.
+func (f *placementFixture) addCargo(t *testing.T, weight, volume float64) int64 {
+	t.Helper()
+
+	ctx := context.Background()
+	id, err := f.s.SaveCargo(ctx, models.Cargo{
+		Title:    fmt.Sprintf("test-cargo-%s-%d", f.suffix, len(f.cargoIDs)),
+		TypeID:   f.typeID,
+		Weight:   weight,
+		Volume:   volume,
+		VesselID: f.vesselID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.cargoIDs = append(f.cargoIDs, id)
+
+	if _, err := f.s.pool.Exec(ctx, `UPDATE cargo SET status = $1 WHERE id = $2`, models.CargoUnloaded, id); err != nil {
+		t.Fatal(err)
+	}
+
+	return id
+}
+
+func (f *placementFixture) addStorageLoc(t *testing.T, maxWeight, maxVolume float64) int64 {
+	t.Helper()
+
+	id, err := f.s.SaveStorageLoc(context.Background(), f.typeID, maxWeight, maxVolume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.locIDs = append(f.locIDs, id)
+
+	return id
+}
+
+func (f *placementFixture) use(storageLocID, cargoID int64) error {
+	return f.s.UseStorageLoc(context.Background(), storageLocID, cargoID,
+		[]models.CargoStatus{models.CargoUnloaded, models.CargoReleased},
+		models.Operation{
+			Title:     "test-placement-" + f.suffix,
+			Kind:      models.OperationPlacement,
+			CreatedAt: time.Now(),
+		},
+	)
+}
+
+func (f *placementFixture) cleanup(t *testing.T) {
+	ctx := context.Background()
+
+	var operationIDs []int64
+	err := f.s.pool.QueryRow(ctx, `
+		SELECT COALESCE(array_agg(operation_id), '{}') FROM operation_cargo WHERE cargo_id = ANY($1)
+	`, f.cargoIDs).Scan(&operationIDs)
+	if err != nil {
+		t.Error(err)
+	}
+
+	for _, c := range []struct {
+		query string
+		args  []any
+	}{
+		{`
+			DELETE FROM audit_log
+			WHERE (entity_type = 'cargo_type' AND entity_id = $1)
+				OR (entity_type = 'vessel' AND entity_id = $2)
+				OR (entity_type = 'cargo' AND entity_id = ANY($3))
+				OR (entity_type = 'storage_loc' AND entity_id = ANY($4))
+				OR (entity_type = 'operation' AND entity_id = ANY($5))
+				OR (entity_type = 'operation_cargo' AND split_part(entity_id, ':', 2) = ANY($3))
+		`, []any{
+			strconv.FormatInt(f.typeID, 10),
+			strconv.FormatInt(f.vesselID, 10),
+			formatIDs(f.cargoIDs),
+			formatIDs(f.locIDs),
+			formatIDs(operationIDs),
+		}},
+		{`DELETE FROM operation_cargo WHERE cargo_id = ANY($1)`, []any{f.cargoIDs}},
+		{`DELETE FROM operation WHERE id = ANY($1)`, []any{operationIDs}},
+		{`DELETE FROM storage_placement WHERE cargo_id = ANY($1)`, []any{f.cargoIDs}},
+		{`DELETE FROM storage_loc WHERE id = ANY($1)`, []any{f.locIDs}},
+		{`DELETE FROM cargo WHERE id = ANY($1)`, []any{f.cargoIDs}},
+		{`DELETE FROM vessel WHERE id = $1`, []any{f.vesselID}},
+		{`DELETE FROM cargo_type WHERE id = $1`, []any{f.typeID}},
+	} {
+		if _, err := f.s.pool.Exec(ctx, c.query, c.args...); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func formatIDs(ids []int64) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, strconv.FormatInt(id, 10))
+	}
+	return out
+}
+
+// runConcurrently starts n calls of fn at the same moment and returns
+// their errors by index.
+func runConcurrently(n int, fn func(i int) error) []error {
+	start := make(chan struct{})
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs[i] = fn(i)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	return errs
+}
+
+// TestUseStorageLocConcurrent places one cargo into many locations at once
+// and checks that exactly one placement wins.
+func TestUseStorageLocConcurrent(t *testing.T) {
+	const locations = 16
+
+	s := newTestStorage(t)
+	ctx := context.Background()
+	f := newPlacementFixture(t, s)
+
+	cargoID := f.addCargo(t, 1, 1)
+	for range locations {
+		f.addStorageLoc(t, 10, 10)
+	}
+
+	errs := runConcurrently(locations, func(i int) error {
+		return f.use(f.locIDs[i], cargoID)
+	})
+
+	var placed int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			placed++
+		case errors.Is(err, storage.ErrCargoAlreadyPlaced):
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if placed != 1 {
+		t.Errorf("got %d successful placements, want 1", placed)
+	}
+
+	var open int
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM storage_placement
+		WHERE cargo_id = $1 AND released_at IS NULL
+	`, cargoID).Scan(&open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if open != 1 {
+		t.Errorf("got %d open placements, want 1", open)
+	}
+}
+
+// TestUseStorageLocCapacityConcurrent places many cargos into one
+// location at once and checks that they never exceed its capacity.
+func TestUseStorageLocCapacityConcurrent(t *testing.T) {
+	const (
+		cargos    = 16
+		maxWeight = 10
+		maxVolume = 10
+		weight    = 3
+		volume    = 2
+		fitting   = 3 // min(maxWeight/weight, maxVolume/volume)
+	)
+
+	s := newTestStorage(t)
+	ctx := context.Background()
+	f := newPlacementFixture(t, s)
+
+	locID := f.addStorageLoc(t, maxWeight, maxVolume)
+	for range cargos {
+		f.addCargo(t, weight, volume)
+	}
+
+	errs := runConcurrently(cargos, func(i int) error {
+		return f.use(locID, f.cargoIDs[i])
+	})
+
+	var placed int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			placed++
+		case errors.Is(err, storage.ErrStorageLocNotSuitable):
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if placed != fitting {
+		t.Errorf("got %d successful placements, want %d", placed, fitting)
+	}
+
+	var usedWeight, usedVolume float64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COALESCE(SUM(c.weight), 0), COALESCE(SUM(c.volume), 0)
+		FROM storage_placement sp
+		JOIN cargo c ON c.id = sp.cargo_id
+		WHERE sp.storage_loc_id = $1 AND sp.released_at IS NULL
+	`, locID).Scan(&usedWeight, &usedVolume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usedWeight > maxWeight {
+		t.Errorf("used weight %v exceeds max weight %v", usedWeight, maxWeight)
+	}
+	if usedVolume > maxVolume {
+		t.Errorf("used volume %v exceeds max volume %v", usedVolume, maxVolume)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"dbcp/internal/domain/models"
 	"dbcp/internal/storage"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -37,16 +39,32 @@ func (s *Storage) Close() {
 	s.pool.Close()
 }
 
+// Ping checks that the database is reachable.
+func (s *Storage) Ping(ctx context.Context) error {
+	const op = "storage.postgresql.Ping"
+
+	if err := s.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
 func (s *Storage) Vessels(
 	ctx context.Context,
+	afterID int64,
+	limit int,
+	includeArchived bool,
 ) ([]models.Vessel, error) {
 	const op = "storage.postgresql.Vessels"
 
 	rows, err := s.pool.Query(ctx, `
-		SELECT id, title, vessel_type, max_load
+		SELECT id, title, vessel_type, max_load, version, deleted_at
 		FROM vessel
+		WHERE id > $1 AND ($3 OR deleted_at IS NULL)
 		ORDER BY id
-	`)
+		LIMIT $2
+	`, afterID, limit, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("%s query: %w", op, err)
 	}
@@ -55,7 +73,7 @@ func (s *Storage) Vessels(
 	var vessels []models.Vessel
 	for rows.Next() {
 		var v models.Vessel
-		if err := rows.Scan(&v.ID, &v.Title, &v.VesselType, &v.MaxLoad); err != nil {
+		if err := rows.Scan(&v.ID, &v.Title, &v.VesselType, &v.MaxLoad, &v.Version, &v.DeletedAt); err != nil {
 			return nil, fmt.Errorf("%s rows: %w", op, err)
 		}
 		vessels = append(vessels, v)
@@ -68,15 +86,39 @@ func (s *Storage) Vessels(
 	return vessels, nil
 }
 
+func (s *Storage) CountVessels(
+	ctx context.Context,
+	includeArchived bool,
+) (int64, error) {
+	const op = "storage.postgresql.CountVessels"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM vessel
+		WHERE $1 OR deleted_at IS NULL
+	`, includeArchived).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
 func (s *Storage) SaveVessel(
 	ctx context.Context,
 	vessel models.Vessel,
 ) (int64, error) {
 	const op = "storage.postgresql.CreateVessel"
 
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
 	var id int64
 
-	err := s.pool.QueryRow(ctx, `
+	err = tx.QueryRow(ctx, `
 		INSERT INTO vessel (title, vessel_type, max_load)
 		VALUES ($1, $2, $3)
 		RETURNING id
@@ -90,29 +132,107 @@ func (s *Storage) SaveVessel(
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := audit(ctx, tx, models.AuditVessel, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return id, nil
 }
 
+// DeleteVessel archives the vessel. Archived rows are hidden from lists
+// and lookups but stay in place for the records that refer to them.
 func (s *Storage) DeleteVessel(
 	ctx context.Context,
 	id int64,
 ) error {
 	const op = "storage.postgresql.DeleteVessel"
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		DELETE FROM vessel 
-		WHERE id = $1
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditVessel, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE vessel
+		SET deleted_at = CURRENT_TIMESTAMP,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditVessel, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RestoreVessel brings an archived vessel back.
+func (s *Storage) RestoreVessel(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.RestoreVessel"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditVessel, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if before == nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE vessel
+		SET deleted_at = NULL,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL
 	`, id)
 	if err != nil {
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
-			return fmt.Errorf("%s: %w", op, storage.ErrVesselInUse)
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("%s: %w", op, storage.ErrVesselExists)
 		}
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
-		return storage.ErrVesselNotFound
+		return fmt.Errorf("%s: %w", op, storage.ErrVesselNotArchived)
+	}
+
+	if err := audit(ctx, tx, models.AuditVessel, models.AuditRestore, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
@@ -121,15 +241,16 @@ func (s *Storage) DeleteVessel(
 func (s *Storage) Vessel(
 	ctx context.Context,
 	id int64,
+	includeArchived bool,
 ) (models.Vessel, error) {
 	const op = "storage.postgresql.Vessel"
 
 	var v models.Vessel
 	err := s.pool.QueryRow(ctx, `
-		SELECT id, title, vessel_type, max_load
+		SELECT id, title, vessel_type, max_load, version, deleted_at
 		FROM vessel
-		WHERE id = $1
-	`, id).Scan(&v.ID, &v.Title, &v.VesselType, &v.MaxLoad)
+		WHERE id = $1 AND ($2 OR deleted_at IS NULL)
+	`, id, includeArchived).Scan(&v.ID, &v.Title, &v.VesselType, &v.MaxLoad, &v.Version, &v.DeletedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Vessel{}, fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
@@ -147,15 +268,62 @@ func (s *Storage) UpdateVessel(
 	title *string,
 	vesselType *string,
 	maxLoad *float64,
+	expectedVersion *int64,
 ) error {
 	const op = "storage.postgresql.UpdateVessel"
 
-	cmdTag, err := s.pool.Exec(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditVessel, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var version int64
+	err = tx.QueryRow(ctx, `
+		SELECT version
+		FROM vessel
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, id).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVersion(version, expectedVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if maxLoad != nil {
+		var fits bool
+		err := tx.QueryRow(ctx, `
+			SELECT COALESCE(SUM(weight), 0) <= $2
+			FROM cargo
+			WHERE vessel_id = $1 AND deleted_at IS NULL
+		`, id, *maxLoad).Scan(&fits)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !fits {
+			return fmt.Errorf("%s: %w", op, storage.ErrVesselOverloaded)
+		}
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
 		UPDATE vessel
 		SET
 			title = COALESCE($1, title),
 			vessel_type = COALESCE($2, vessel_type),
-			max_load = COALESCE($3, max_load)
+			max_load = COALESCE($3, max_load),
+			version = version + 1
 		WHERE id = $4
 	`, title, vesselType, maxLoad, id)
 
@@ -171,19 +339,56 @@ func (s *Storage) UpdateVessel(
 		return fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
 	}
 
+	if err := audit(ctx, tx, models.AuditVessel, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
+func (s *Storage) VesselLoad(
+	ctx context.Context,
+	id int64,
+) (models.VesselLoad, error) {
+	const op = "storage.postgresql.VesselLoad"
+
+	var load models.VesselLoad
+	err := s.pool.QueryRow(ctx, `
+		SELECT v.id, v.max_load, COALESCE(SUM(c.weight), 0)
+		FROM vessel v
+		LEFT JOIN cargo c ON c.vessel_id = v.id AND c.deleted_at IS NULL
+		WHERE v.id = $1
+		GROUP BY v.id
+	`, id).Scan(&load.VesselID, &load.MaxLoad, &load.CurrentLoad)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.VesselLoad{}, fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
+		}
+		return models.VesselLoad{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return load, nil
+}
+
 func (s *Storage) CargoTypes(
 	ctx context.Context,
+	afterID int64,
+	limit int,
+	includeArchived bool,
 ) ([]models.CargoType, error) {
 	const op = "storage.postgresql.CargoTypes"
 
 	rows, err := s.pool.Query(ctx, `
-		SELECT id, title, process_cost
+		SELECT id, title, process_cost, version, deleted_at
 		FROM cargo_type
+		WHERE id > $1 AND ($3 OR deleted_at IS NULL)
 		ORDER BY id
-	`)
+		LIMIT $2
+	`, afterID, limit, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("%s query: %w", op, err)
 	}
@@ -192,7 +397,7 @@ func (s *Storage) CargoTypes(
 	var cargoTypes []models.CargoType
 	for rows.Next() {
 		var ct models.CargoType
-		if err := rows.Scan(&ct.ID, &ct.Title, &ct.ProcessCost); err != nil {
+		if err := rows.Scan(&ct.ID, &ct.Title, &ct.ProcessCost, &ct.Version, &ct.DeletedAt); err != nil {
 			return nil, fmt.Errorf("%s rows: %w", op, err)
 		}
 		cargoTypes = append(cargoTypes, ct)
@@ -205,15 +410,39 @@ func (s *Storage) CargoTypes(
 	return cargoTypes, nil
 }
 
+func (s *Storage) CountCargoTypes(
+	ctx context.Context,
+	includeArchived bool,
+) (int64, error) {
+	const op = "storage.postgresql.CountCargoTypes"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM cargo_type
+		WHERE $1 OR deleted_at IS NULL
+	`, includeArchived).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
 func (s *Storage) SaveCargoType(
 	ctx context.Context,
 	cargoType models.CargoType,
 ) (int64, error) {
 	const op = "storage.postgresql.SaveCargoType"
 
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
 	var id int64
 
-	err := s.pool.QueryRow(ctx, `
+	err = tx.QueryRow(ctx, `
 		INSERT INTO cargo_type (title, process_cost)
 		VALUES($1, $2)
 		RETURNING id
@@ -227,30 +456,107 @@ func (s *Storage) SaveCargoType(
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := audit(ctx, tx, models.AuditCargoType, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return id, nil
 }
 
+// DeleteCargoType archives the cargo type. Archived rows are hidden from lists
+// and lookups but stay in place for the records that refer to them.
 func (s *Storage) DeleteCargoType(
 	ctx context.Context,
 	id int64,
 ) error {
 	const op = "storage.postgresql.DeleteCargoType"
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		DELETE FROM cargo_type
-		WHERE id = $1
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargoType, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE cargo_type
+		SET deleted_at = CURRENT_TIMESTAMP,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditCargoType, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RestoreCargoType brings an archived cargo type back.
+func (s *Storage) RestoreCargoType(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.RestoreCargoType"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargoType, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if before == nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeNotFound)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE cargo_type
+		SET deleted_at = NULL,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL
 	`, id)
 	if err != nil {
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
-			return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeInUse)
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeExists)
 		}
-
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeNotFound)
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeNotArchived)
+	}
+
+	if err := audit(ctx, tx, models.AuditCargoType, models.AuditRestore, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
@@ -259,15 +565,16 @@ func (s *Storage) DeleteCargoType(
 func (s *Storage) CargoType(
 	ctx context.Context,
 	id int64,
+	includeArchived bool,
 ) (models.CargoType, error) {
 	const op = "storage.postgresql.CargoType"
 
 	var ct models.CargoType
 	err := s.pool.QueryRow(ctx, `
-		SELECT id, title, process_cost
+		SELECT id, title, process_cost, version, deleted_at
 		FROM cargo_type
-		WHERE id = $1
-	`, id).Scan(&ct.ID, &ct.Title, &ct.ProcessCost)
+		WHERE id = $1 AND ($2 OR deleted_at IS NULL)
+	`, id, includeArchived).Scan(&ct.ID, &ct.Title, &ct.ProcessCost, &ct.Version, &ct.DeletedAt)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -285,14 +592,45 @@ func (s *Storage) UpdateCargoType(
 	id int64,
 	title *string,
 	processCost *float64,
+	expectedVersion *int64,
 ) error {
 	const op = "storage.postgresql.UpdateCargoType"
 
-	cmdTag, err := s.pool.Exec(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargoType, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var version int64
+	err = tx.QueryRow(ctx, `
+		SELECT version
+		FROM cargo_type
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, id).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVersion(version, expectedVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.Exec(ctx, `
 		UPDATE cargo_type
 		SET
 			title = COALESCE($1, title),
-			process_cost = COALESCE($2, process_cost)
+			process_cost = COALESCE($2, process_cost),
+			version = version + 1
 		WHERE id = $3
 	`, title, processCost, id)
 	if err != nil {
@@ -304,8 +642,12 @@ func (s *Storage) UpdateCargoType(
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrCargoTypeNotFound)
+	if err := audit(ctx, tx, models.AuditCargoType, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
@@ -313,16 +655,20 @@ func (s *Storage) UpdateCargoType(
 
 func (s *Storage) Operations(
 	ctx context.Context,
+	afterID int64,
+	limit int,
 ) ([]models.Operation, error) {
-	const op = "storage.postgresql.Operation"
+	const op = "storage.postgresql.Operations"
 
 	var operations []models.Operation
 
 	rows, err := s.pool.Query(ctx, `
-		SELECT id, title, created_at
+		SELECT id, title, kind, created_at, version
 		FROM operation
+		WHERE id > $1
 		ORDER BY id
-	`)
+		LIMIT $2
+	`, afterID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -330,7 +676,7 @@ func (s *Storage) Operations(
 
 	for rows.Next() {
 		var o models.Operation
-		if err := rows.Scan(&o.ID, &o.Title, &o.CreatedAt); err != nil {
+		if err := rows.Scan(&o.ID, &o.Title, &o.Kind, &o.CreatedAt, &o.Version); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		operations = append(operations, o)
@@ -343,23 +689,53 @@ func (s *Storage) Operations(
 	return operations, nil
 }
 
+func (s *Storage) CountOperations(
+	ctx context.Context,
+) (int64, error) {
+	const op = "storage.postgresql.CountOperations"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM operation
+	`).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
 func (s *Storage) SaveOperation(
 	ctx context.Context,
 	operation models.Operation,
 ) (int64, error) {
 	const op = "storage.postgresql.SaveOperation"
 
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
 	var id int64
 
-	err := s.pool.QueryRow(ctx, `
-		INSERT INTO operation (title)
-		VALUES ($1)
+	err = tx.QueryRow(ctx, `
+		INSERT INTO operation (title, kind)
+		VALUES ($1, $2)
 		RETURNING id
-	`, operation.Title).Scan(&id)
+	`, operation.Title, operation.Kind).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := audit(ctx, tx, models.AuditOperation, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return id, nil
 }
 
@@ -369,7 +745,18 @@ func (s *Storage) DeleteOperation(
 ) error {
 	const op = "storage.postgresql.DeleteOperation"
 
-	cmdTag, err := s.pool.Exec(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditOperation, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
 		DELETE FROM operation
 		WHERE id = $1
 	`, id)
@@ -385,6 +772,14 @@ func (s *Storage) DeleteOperation(
 		return fmt.Errorf("%s: %w", op, storage.ErrOperationNotFound)
 	}
 
+	if err := audit(ctx, tx, models.AuditOperation, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -396,10 +791,10 @@ func (s *Storage) Operation(
 
 	var o models.Operation
 	err := s.pool.QueryRow(ctx, `
-		SELECT id, title, created_at
+		SELECT id, title, kind, created_at, version
 		FROM operation
 		WHERE id = $1
-	`, id).Scan(&o.ID, &o.Title, &o.CreatedAt)
+	`, id).Scan(&o.ID, &o.Title, &o.Kind, &o.CreatedAt, &o.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Operation{}, fmt.Errorf("%s: %w", op, storage.ErrOperationNotFound)
@@ -415,20 +810,57 @@ func (s *Storage) UpdateOperation(
 	ctx context.Context,
 	id int64,
 	title *string,
+	kind *models.OperationKind,
+	expectedVersion *int64,
 ) error {
 	const op = "storage.postgresql.UpdateOperation"
 
-	cmdTag, err := s.pool.Exec(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditOperation, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var version int64
+	err = tx.QueryRow(ctx, `
+		SELECT version
+		FROM operation
+		WHERE id = $1
+		FOR UPDATE
+	`, id).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrOperationNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVersion(version, expectedVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.Exec(ctx, `
 		UPDATE operation
-		SET title = COALESCE($1, title)
-		WHERE id = $2
-	`, title, id)
+		SET title = COALESCE($1, title),
+			kind = COALESCE($2, kind),
+			version = version + 1
+		WHERE id = $3
+	`, title, kind, id)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrOperationNotFound)
+	if err := audit(ctx, tx, models.AuditOperation, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
@@ -436,14 +868,19 @@ func (s *Storage) UpdateOperation(
 
 func (s *Storage) Cargos(
 	ctx context.Context,
+	afterID int64,
+	limit int,
+	includeArchived bool,
 ) ([]models.Cargo, error) {
 	const op = "storage.postgresql.Cargos"
 
 	rows, err := s.pool.Query(ctx, `
-		SELECT id, title, type_id, weight, volume, vessel_id
+		SELECT id, title, type_id, weight, volume, vessel_id, port_call_id, status, version, deleted_at
 		FROM cargo
+		WHERE id > $1 AND ($3 OR deleted_at IS NULL)
 		ORDER BY id
-	`)
+		LIMIT $2
+	`, afterID, limit, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -457,7 +894,11 @@ func (s *Storage) Cargos(
 			&c.TypeID,
 			&c.Weight,
 			&c.Volume,
-			&c.VesselID); err != nil {
+			&c.VesselID,
+			&c.PortCallID,
+			&c.Status,
+			&c.Version,
+			&c.DeletedAt); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		cargos = append(cargos, c)
@@ -470,168 +911,207 @@ func (s *Storage) Cargos(
 	return cargos, nil
 }
 
-func (s *Storage) SaveCargo(
+func (s *Storage) CountCargos(
 	ctx context.Context,
-	cargo models.Cargo,
+	includeArchived bool,
 ) (int64, error) {
-	const op = "storage.postgresql.SaveCargo"
-
-	var id int64
+	const op = "storage.postgresql.CountCargos"
 
+	var count int64
 	err := s.pool.QueryRow(ctx, `
-		INSERT INTO cargo (title, type_id, weight, volume, vessel_id)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id
-	`, cargo.Title, cargo.TypeID, cargo.Weight, cargo.Volume, cargo.VesselID).Scan(&id)
+		SELECT COUNT(*) FROM cargo
+		WHERE $1 OR deleted_at IS NULL
+	`, includeArchived).Scan(&count)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
-			return 0, fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
-		}
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return id, nil
+	return count, nil
 }
 
-func (s *Storage) DeleteCargo(
+var cargoSortColumns = map[models.CargoSortField]string{
+	models.CargoSortByID:     "c.id",
+	models.CargoSortByTitle:  "c.title",
+	models.CargoSortByWeight: "c.weight",
+	models.CargoSortByVolume: "c.volume",
+}
+
+func (s *Storage) SearchCargos(
 	ctx context.Context,
-	id int64,
-) error {
-	const op = "storage.postgresql.DeleteCargo"
+	filter models.CargoFilter,
+	offset int,
+	limit int,
+) ([]models.Cargo, error) {
+	const op = "storage.postgresql.SearchCargos"
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		DELETE FROM cargo	
-		WHERE id = $1
-	`, id)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
-			return fmt.Errorf("%s: %w", op, storage.ErrCargoInUse)
-		}
-		return fmt.Errorf("%s: %w", op, err)
+	sortColumn, ok := cargoSortColumns[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown sort field %d", op, filter.SortBy)
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
 	}
 
-	return nil
-}
+	conds, args := cargoFilterConds(filter)
 
-func (s *Storage) Cargo(
-	ctx context.Context,
-	id int64,
-) (models.Cargo, error) {
-	const op = "storage.postgresql.CargoByID"
+	query := `
+		SELECT c.id, c.title, c.type_id, c.weight, c.volume, c.vessel_id, c.port_call_id, c.status, c.version, c.deleted_at
+		FROM cargo c`
+	if len(conds) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(conds, "\n\t\t\tAND ")
+	}
 
-	var c models.Cargo
+	args = append(args, limit, offset)
+	query += fmt.Sprintf(`
+		ORDER BY %s %s, c.id %s
+		LIMIT $%d OFFSET $%d
+	`, sortColumn, direction, direction, len(args)-1, len(args))
 
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, title, type_id, weight, volume, vessel_id
-		FROM cargo
-		WHERE id = $1
-	`, id).Scan(&c.ID, &c.Title, &c.TypeID, &c.Weight, &c.Volume, &c.VesselID)
+	rows, err := s.pool.Query(ctx, query, args...)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return models.Cargo{}, fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var cargos []models.Cargo
+	for rows.Next() {
+		var c models.Cargo
+		if err := rows.Scan(&c.ID,
+			&c.Title,
+			&c.TypeID,
+			&c.Weight,
+			&c.Volume,
+			&c.VesselID,
+			&c.PortCallID,
+			&c.Status,
+			&c.Version,
+			&c.DeletedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-		return models.Cargo{}, fmt.Errorf("%s: %w", op, err)
+		cargos = append(cargos, c)
 	}
 
-	return c, nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return cargos, nil
 }
 
-func (s *Storage) UpdateCargo(
+// CountSearchCargos returns the number of cargos SearchCargos finds for
+// filter across all pages.
+func (s *Storage) CountSearchCargos(
 	ctx context.Context,
-	id int64,
-	title *string,
-	typeID *int64,
-	weight *float64,
-	volume *float64,
-	vesselID *int64,
-) error {
-	const op = "storage.postgresql.UpdateCargo"
+	filter models.CargoFilter,
+) (int64, error) {
+	const op = "storage.postgresql.CountSearchCargos"
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		UPDATE cargo
-		SET title = COALESCE($1, title),
-			type_id = COALESCE($2, type_id),
-			weight = COALESCE($3, weight),
-			volume = COALESCE($4, volume),
-			vessel_id = COALESCE($5, vessel_id)
-		WHERE id = $6
-	`, title, typeID, weight, volume, vesselID, id)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
-			return fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
-		}
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return fmt.Errorf("%s: %w", op, storage.ErrCargoExists)
-		}
-		return fmt.Errorf("%s: %w", op, err)
+	conds, args := cargoFilterConds(filter)
+
+	query := `
+		SELECT COUNT(*)
+		FROM cargo c`
+	if len(conds) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(conds, "\n\t\t\tAND ")
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+	var count int64
+	if err := s.pool.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return count, nil
 }
 
-func (s *Storage) StorageLocations(
-	ctx context.Context,
-) ([]models.StorageLocation, error) {
-	const op = "storage.postgresql.StorageLocations"
-
-	rows, err := s.pool.Query(ctx, `
-		SELECT id, cargo_type_id, max_weight,
-			max_volume, cargo_id, date_of_placement
-		FROM storage_loc
-		ORDER BY id
-	`)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+// cargoFilterConds turns filter into the WHERE conditions on cargo c and
+// their numbered arguments.
+func cargoFilterConds(filter models.CargoFilter) ([]string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
 	}
-	defer rows.Close()
 
-	var storageLocations []models.StorageLocation
-	for rows.Next() {
-		var sl models.StorageLocation
-		if err := rows.Scan(&sl.ID,
-			&sl.CargoTypeID,
-			&sl.MaxWeight,
-			&sl.MaxVolume,
-			&sl.CargoID,
-			&sl.DateOfPlacement,
-		); err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+	if filter.TypeID != nil {
+		where("c.type_id = $%d", *filter.TypeID)
+	}
+	if filter.VesselID != nil {
+		where("c.vessel_id = $%d", *filter.VesselID)
+	}
+	if filter.PortCallID != nil {
+		where("c.port_call_id = $%d", *filter.PortCallID)
+	}
+	if filter.MinWeight != nil {
+		where("c.weight >= $%d", *filter.MinWeight)
+	}
+	if filter.MaxWeight != nil {
+		where("c.weight <= $%d", *filter.MaxWeight)
+	}
+	if filter.MinVolume != nil {
+		where("c.volume >= $%d", *filter.MinVolume)
+	}
+	if filter.MaxVolume != nil {
+		where("c.volume <= $%d", *filter.MaxVolume)
+	}
+	if filter.TitleContains != nil {
+		where(`c.title ILIKE $%d ESCAPE '\'`, "%"+escapeLike(*filter.TitleContains)+"%")
+	}
+	if filter.Status != nil {
+		where("c.status = $%d", *filter.Status)
+	}
+	if !filter.IncludeArchived {
+		conds = append(conds, "c.deleted_at IS NULL")
+	}
+	if filter.Placed != nil {
+		placed := "EXISTS (SELECT 1 FROM storage_placement sp WHERE sp.cargo_id = c.id AND sp.released_at IS NULL)"
+		if !*filter.Placed {
+			placed = "NOT " + placed
 		}
-		storageLocations = append(storageLocations, sl)
+		conds = append(conds, placed)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
+	return conds, args
+}
 
-	return storageLocations, nil
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
-func (s *Storage) SaveStorageLoc(
+func (s *Storage) SaveCargo(
 	ctx context.Context,
-	cargoTypeID int64,
-	maxWeight float64,
-	maxVolume float64,
+	cargo models.Cargo,
 ) (int64, error) {
-	const op = "storage.postgresql.SaveStorageLoc"
+	const op = "storage.postgresql.SaveCargo"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkVesselLoad(ctx, tx, cargo.VesselID, 0, cargo.Weight); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkNotArchived(ctx, tx, "vessel", cargo.VesselID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkNotArchived(ctx, tx, "cargo_type", cargo.TypeID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
 
 	var id int64
-	err := s.pool.QueryRow(ctx, `
-		INSERT INTO storage_loc (cargo_type_id, max_weight, max_volume)
-		VALUES($1, $2, $3)
+	err = tx.QueryRow(ctx, `
+		INSERT INTO cargo (title, type_id, weight, volume, vessel_id, port_call_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id
-	`, cargoTypeID, maxWeight, maxVolume).Scan(&id)
+	`, cargo.Title, cargo.TypeID, cargo.Weight, cargo.Volume, cargo.VesselID, cargo.PortCallID).Scan(&id)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
@@ -640,279 +1120,2259 @@ func (s *Storage) SaveStorageLoc(
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := audit(ctx, tx, models.AuditCargo, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return id, nil
 }
 
-func (s *Storage) DeleteStorageLoc(
+// checkVesselLoad locks the vessel row and makes sure that the cargo
+// already assigned to it, except excludeCargoID and archived cargo, plus
+// the extra weight fits into max_load. Must be called inside a
+// transaction.
+func checkVesselLoad(
 	ctx context.Context,
-	id int64,
+	tx pgx.Tx,
+	vesselID int64,
+	excludeCargoID int64,
+	weight float64,
 ) error {
-	const op = "storage.postgresql.DeleteStorageLoc"
-
-	var inUse bool
-	err := s.pool.QueryRow(ctx, `
-		SELECT EXISTS (
-			SELECT 1 FROM storage_loc WHERE id = $1 AND cargo_id IS NOT NULL
-		)
-	`, id).Scan(&inUse)
-	if err != nil {
+	var maxLoad float64
+	err := tx.QueryRow(ctx, `
+		SELECT max_load
+		FROM vessel
+		WHERE id = $1
+		FOR UPDATE
+	`, vesselID).Scan(&maxLoad)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrRelatedEntityNotFound
+		}
+		return err
+	}
+
+	var fits bool
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(SUM(weight), 0) + $3 <= $4
+		FROM cargo
+		WHERE vessel_id = $1 AND id <> $2 AND deleted_at IS NULL
+	`, vesselID, excludeCargoID, weight, maxLoad).Scan(&fits)
+	if err != nil {
+		return err
+	}
+
+	if !fits {
+		return storage.ErrVesselOverloaded
+	}
+
+	return nil
+}
+
+// checkPlacementFits locks the open placement of the cargo and its storage
+// location, and makes sure the cargo with the new type, weight and volume
+// still suits the location. Unset values keep the current ones. Cargo that
+// is not placed always fits. The cargo row must already be locked.
+func checkPlacementFits(
+	ctx context.Context,
+	tx pgx.Tx,
+	cargoID int64,
+	typeID *int64,
+	weight *float64,
+	volume *float64,
+) error {
+	var storageLocID int64
+	err := tx.QueryRow(ctx, `
+		SELECT storage_loc_id
+		FROM storage_placement
+		WHERE cargo_id = $1 AND released_at IS NULL
+		FOR UPDATE
+	`, cargoID).Scan(&storageLocID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if err := lockStorageLoc(ctx, tx, storageLocID); err != nil {
+		return err
+	}
+
+	var isCargoType, fits bool
+	err = tx.QueryRow(ctx, `
+		SELECT sl.cargo_type_id = COALESCE($3, c.type_id),
+			u.used_weight + COALESCE($4, c.weight) <= sl.max_weight
+				AND u.used_volume + COALESCE($5, c.volume) <= sl.max_volume
+		FROM storage_loc sl
+		JOIN cargo c ON c.id = $2
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(pc.weight), 0) AS used_weight,
+				COALESCE(SUM(pc.volume), 0) AS used_volume
+			FROM storage_placement sp
+			JOIN cargo pc ON pc.id = sp.cargo_id
+			WHERE sp.storage_loc_id = sl.id
+				AND sp.released_at IS NULL
+				AND sp.cargo_id <> c.id
+		) u
+		WHERE sl.id = $1
+	`, storageLocID, cargoID, typeID, weight, volume).Scan(&isCargoType, &fits)
+	if err != nil {
+		return err
+	}
+
+	if !isCargoType {
+		return storage.ErrStorageLocTypeNotSuitable
+	}
+
+	if !fits {
+		return storage.ErrStorageLocNotSuitable
+	}
+
+	return nil
+}
+
+// checkNotArchived makes sure the referenced row exists and is not
+// archived, so that retired master data does not get new references.
+// Must be called inside a transaction.
+func checkNotArchived(
+	ctx context.Context,
+	tx pgx.Tx,
+	table string,
+	id int64,
+) error {
+	var active bool
+	err := tx.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND deleted_at IS NULL)
+	`, table), id).Scan(&active)
+	if err != nil {
+		return err
+	}
+
+	if !active {
+		return storage.ErrRelatedEntityNotFound
+	}
+
+	return nil
+}
+
+// DeleteCargo archives the cargo. Archived rows are hidden from lists
+// and lookups but stay in place for the records that refer to them.
+func (s *Storage) DeleteCargo(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.DeleteCargo"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargo, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// A cargo lying in a storage location has to be released first.
+	var isPlaced bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM storage_placement
+			WHERE cargo_id = $1 AND released_at IS NULL
+		)
+	`, id).Scan(&isPlaced)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if isPlaced {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoInUse)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE cargo
+		SET deleted_at = CURRENT_TIMESTAMP,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditCargo, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RestoreCargo brings an archived cargo back.
+func (s *Storage) RestoreCargo(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.RestoreCargo"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargo, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if before == nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+	}
+
+	var (
+		vesselID int64
+		typeID   int64
+		weight   float64
+	)
+	err = tx.QueryRow(ctx, `
+		UPDATE cargo
+		SET deleted_at = NULL,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		RETURNING vessel_id, type_id, weight
+	`, id).Scan(&vesselID, &typeID, &weight)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrCargoNotArchived)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// The vessel or cargo type may have been archived while the cargo was.
+	if err := checkNotArchived(ctx, tx, "vessel", vesselID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkNotArchived(ctx, tx, "cargo_type", typeID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Archived cargo does not count toward the vessel load, so the
+	// vessel may have been filled up in the meantime.
+	if err := checkVesselLoad(ctx, tx, vesselID, id, weight); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditCargo, models.AuditRestore, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Cargo(
+	ctx context.Context,
+	id int64,
+	includeArchived bool,
+) (models.Cargo, error) {
+	const op = "storage.postgresql.CargoByID"
+
+	var c models.Cargo
+
+	err := s.pool.QueryRow(ctx, `
+		SELECT id, title, type_id, weight, volume, vessel_id, port_call_id, status, version, deleted_at
+		FROM cargo
+		WHERE id = $1 AND ($2 OR deleted_at IS NULL)
+	`, id, includeArchived).Scan(&c.ID, &c.Title, &c.TypeID, &c.Weight, &c.Volume, &c.VesselID, &c.PortCallID, &c.Status, &c.Version, &c.DeletedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Cargo{}, fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+		}
+		return models.Cargo{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return c, nil
+}
+
+func (s *Storage) UpdateCargo(
+	ctx context.Context,
+	id int64,
+	title *string,
+	typeID *int64,
+	weight *float64,
+	volume *float64,
+	vesselID *int64,
+	portCallID *int64,
+	expectedVersion *int64,
+) error {
+	const op = "storage.postgresql.UpdateCargo"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargo, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var (
+		curVesselID int64
+		curWeight   float64
+		curVersion  int64
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT vessel_id, weight, version
+		FROM cargo
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, id).Scan(&curVesselID, &curWeight, &curVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVersion(curVersion, expectedVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if vesselID != nil {
+		if err := checkNotArchived(ctx, tx, "vessel", *vesselID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if typeID != nil {
+		if err := checkNotArchived(ctx, tx, "cargo_type", *typeID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if vesselID != nil || weight != nil {
+		newVesselID, newWeight := curVesselID, curWeight
+		if vesselID != nil {
+			newVesselID = *vesselID
+		}
+		if weight != nil {
+			newWeight = *weight
+		}
+
+		if err := checkVesselLoad(ctx, tx, newVesselID, id, newWeight); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if typeID != nil || weight != nil || volume != nil {
+		if err := checkPlacementFits(ctx, tx, id, typeID, weight, volume); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE cargo
+		SET title = COALESCE($1, title),
+			type_id = COALESCE($2, type_id),
+			weight = COALESCE($3, weight),
+			volume = COALESCE($4, volume),
+			vessel_id = COALESCE($5, vessel_id),
+			port_call_id = COALESCE($6, port_call_id),
+			version = version + 1
+		WHERE id = $7
+	`, title, typeID, weight, volume, vesselID, portCallID, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
+		}
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("%s: %w", op, storage.ErrCargoExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditCargo, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkVersion returns ErrConcurrentModification when an expected version
+// is given and the row has moved past it.
+func checkVersion(current int64, expected *int64) error {
+	if expected != nil && *expected != current {
+		return storage.ErrConcurrentModification
+	}
+	return nil
+}
+
+// SetCargoStatus moves a cargo to a new status provided it is currently in
+// one of the from statuses, and records the transition as an operation.
+func (s *Storage) SetCargoStatus(
+	ctx context.Context,
+	id int64,
+	from []models.CargoStatus,
+	to models.CargoStatus,
+	operation models.Operation,
+) error {
+	const op = "storage.postgresql.SetCargoStatus"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditCargo, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := setCargoStatus(ctx, tx, id, from, to); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := recordCargoOperation(ctx, tx, operation, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditCargo, models.AuditTransition, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// setCargoStatus updates the cargo status guarded by the allowed from
+// statuses. Must be called inside a transaction.
+func setCargoStatus(
+	ctx context.Context,
+	tx pgx.Tx,
+	id int64,
+	from []models.CargoStatus,
+	to models.CargoStatus,
+) error {
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE cargo
+		SET status = $3,
+			version = version + 1
+		WHERE id = $1 AND status = ANY($2::text[]::cargo_status[]) AND deleted_at IS NULL
+	`, id, from, to)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		err := tx.QueryRow(ctx, `
+			SELECT EXISTS(SELECT 1 FROM cargo WHERE id = $1 AND deleted_at IS NULL)
+		`, id).Scan(&exists)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return storage.ErrCargoNotFound
+		}
+
+		return storage.ErrCargoStatusTransitionNotAllowed
+	}
+
+	return nil
+}
+
+// recordCargoOperation saves the operation and links it to the cargos.
+// Must be called inside a transaction.
+func recordCargoOperation(
+	ctx context.Context,
+	tx pgx.Tx,
+	operation models.Operation,
+	cargoIDs ...int64,
+) error {
+	var operationID int64
+	err := tx.QueryRow(ctx, `
+		INSERT INTO operation (title, kind, created_at)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`, operation.Title, operation.Kind, operation.CreatedAt).Scan(&operationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO operation_cargo (operation_id, cargo_id)
+		SELECT $1, unnest($2::integer[])
+	`, operationID, cargoIDs)
+
+	return err
+}
+
+func (s *Storage) PortCalls(
+	ctx context.Context,
+	vesselID *int64,
+	afterID int64,
+	limit int,
+) ([]models.PortCall, error) {
+	const op = "storage.postgresql.PortCalls"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, vessel_id, voyage_number, status, eta, etd, ata, atd
+		FROM port_call
+		WHERE id > $1 AND ($2::integer IS NULL OR vessel_id = $2)
+		ORDER BY id
+		LIMIT $3
+	`, afterID, vesselID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var portCalls []models.PortCall
+	for rows.Next() {
+		var pc models.PortCall
+		if err := rows.Scan(&pc.ID,
+			&pc.VesselID,
+			&pc.VoyageNumber,
+			&pc.Status,
+			&pc.ETA,
+			&pc.ETD,
+			&pc.ATA,
+			&pc.ATD,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		portCalls = append(portCalls, pc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return portCalls, nil
+}
+
+func (s *Storage) CountPortCalls(
+	ctx context.Context,
+	vesselID *int64,
+) (int64, error) {
+	const op = "storage.postgresql.CountPortCalls"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM port_call
+		WHERE $1::integer IS NULL OR vessel_id = $1
+	`, vesselID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+func (s *Storage) SavePortCall(
+	ctx context.Context,
+	portCall models.PortCall,
+) (int64, error) {
+	const op = "storage.postgresql.SavePortCall"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkNotArchived(ctx, tx, "vessel", portCall.VesselID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO port_call (vessel_id, voyage_number, eta, etd)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, portCall.VesselID, portCall.VoyageNumber, portCall.ETA, portCall.ETD).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrPortCallExists)
+			case "23503":
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
+			}
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditPortCall, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) PortCall(
+	ctx context.Context,
+	id int64,
+) (models.PortCall, error) {
+	const op = "storage.postgresql.PortCall"
+
+	var pc models.PortCall
+	err := s.pool.QueryRow(ctx, `
+		SELECT id, vessel_id, voyage_number, status, eta, etd, ata, atd
+		FROM port_call
+		WHERE id = $1
+	`, id).Scan(&pc.ID,
+		&pc.VesselID,
+		&pc.VoyageNumber,
+		&pc.Status,
+		&pc.ETA,
+		&pc.ETD,
+		&pc.ATA,
+		&pc.ATD,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.PortCall{}, fmt.Errorf("%s: %w", op, storage.ErrPortCallNotFound)
+		}
+		return models.PortCall{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pc, nil
+}
+
+func (s *Storage) UpdatePortCall(
+	ctx context.Context,
+	id int64,
+	voyageNumber *string,
+	eta *time.Time,
+	etd *time.Time,
+) error {
+	const op = "storage.postgresql.UpdatePortCall"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditPortCall, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE port_call
+		SET voyage_number = COALESCE($1, voyage_number),
+			eta = COALESCE($2, eta),
+			etd = COALESCE($3, etd)
+		WHERE id = $4
+	`, voyageNumber, eta, etd, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return fmt.Errorf("%s: %w", op, storage.ErrPortCallExists)
+			case "23514":
+				return fmt.Errorf("%s: %w", op, storage.ErrPortCallStatusConflict)
+			}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrPortCallNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditPortCall, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeletePortCall(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.DeletePortCall"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditPortCall, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		DELETE FROM port_call
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, storage.ErrPortCallInUse)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrPortCallNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditPortCall, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetPortCallStatus moves a port call from one of the allowed statuses
+// to the next one, stamping ATA or ATD when they are given.
+func (s *Storage) SetPortCallStatus(
+	ctx context.Context,
+	id int64,
+	from []models.PortCallStatus,
+	to models.PortCallStatus,
+	ata *time.Time,
+	atd *time.Time,
+) error {
+	const op = "storage.postgresql.SetPortCallStatus"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditPortCall, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE port_call
+		SET status = $3,
+			ata = COALESCE($4, ata),
+			atd = COALESCE($5, atd)
+		WHERE id = $1 AND status = ANY($2)
+	`, id, from, to, ata, atd)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23514" {
+			return fmt.Errorf("%s: %w", op, storage.ErrPortCallStatusConflict)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		err := tx.QueryRow(ctx, `
+			SELECT EXISTS(SELECT 1 FROM port_call WHERE id = $1)
+		`, id).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !exists {
+			return fmt.Errorf("%s: %w", op, storage.ErrPortCallNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", op, storage.ErrPortCallStatusConflict)
+	}
+
+	if err := audit(ctx, tx, models.AuditPortCall, models.AuditTransition, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Berths(
+	ctx context.Context,
+	afterID int64,
+	limit int,
+) ([]models.Berth, error) {
+	const op = "storage.postgresql.Berths"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, title, length, max_draft, allowed_vessel_types
+		FROM berth
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var berths []models.Berth
+	for rows.Next() {
+		var b models.Berth
+		if err := rows.Scan(&b.ID,
+			&b.Title,
+			&b.Length,
+			&b.MaxDraft,
+			&b.AllowedVesselTypes,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		berths = append(berths, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return berths, nil
+}
+
+func (s *Storage) CountBerths(
+	ctx context.Context,
+) (int64, error) {
+	const op = "storage.postgresql.CountBerths"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM berth
+	`).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+func (s *Storage) SaveBerth(
+	ctx context.Context,
+	berth models.Berth,
+) (int64, error) {
+	const op = "storage.postgresql.SaveBerth"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	allowedTypes := berth.AllowedVesselTypes
+	if allowedTypes == nil {
+		allowedTypes = []string{}
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO berth (title, length, max_draft, allowed_vessel_types)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, berth.Title, berth.Length, berth.MaxDraft, allowedTypes).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrBerthExists)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditBerth, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) Berth(
+	ctx context.Context,
+	id int64,
+) (models.Berth, error) {
+	const op = "storage.postgresql.Berth"
+
+	var b models.Berth
+	err := s.pool.QueryRow(ctx, `
+		SELECT id, title, length, max_draft, allowed_vessel_types
+		FROM berth
+		WHERE id = $1
+	`, id).Scan(&b.ID, &b.Title, &b.Length, &b.MaxDraft, &b.AllowedVesselTypes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Berth{}, fmt.Errorf("%s: %w", op, storage.ErrBerthNotFound)
+		}
+		return models.Berth{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return b, nil
+}
+
+func (s *Storage) UpdateBerth(
+	ctx context.Context,
+	id int64,
+	title *string,
+	length *float64,
+	maxDraft *float64,
+	allowedVesselTypes *[]string,
+) error {
+	const op = "storage.postgresql.UpdateBerth"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditBerth, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var allowedTypes []string
+	if allowedVesselTypes != nil {
+		allowedTypes = *allowedVesselTypes
+		if allowedTypes == nil {
+			allowedTypes = []string{}
+		}
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE berth
+		SET title = COALESCE($1, title),
+			length = COALESCE($2, length),
+			max_draft = COALESCE($3, max_draft),
+			allowed_vessel_types = COALESCE($4, allowed_vessel_types)
+		WHERE id = $5
+	`, title, length, maxDraft, allowedTypes, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("%s: %w", op, storage.ErrBerthExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrBerthNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditBerth, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteBerth(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.DeleteBerth"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditBerth, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		DELETE FROM berth
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, storage.ErrBerthInUse)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrBerthNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditBerth, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) BerthAllocations(
+	ctx context.Context,
+	filter models.BerthAllocationFilter,
+	afterID int64,
+	limit int,
+) ([]models.BerthAllocation, error) {
+	const op = "storage.postgresql.BerthAllocations"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, berth_id, vessel_id, port_call_id, lower(period), upper(period)
+		FROM berth_allocation
+		WHERE id > $1
+			AND ($2::integer IS NULL OR berth_id = $2)
+			AND ($3::integer IS NULL OR vessel_id = $3)
+			AND period && tstzrange($4::timestamptz, $5::timestamptz, '[)')
+		ORDER BY id
+		LIMIT $6
+	`, afterID, filter.BerthID, filter.VesselID, filter.From, filter.To, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var allocations []models.BerthAllocation
+	for rows.Next() {
+		var a models.BerthAllocation
+		if err := rows.Scan(&a.ID,
+			&a.BerthID,
+			&a.VesselID,
+			&a.PortCallID,
+			&a.StartsAt,
+			&a.EndsAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		allocations = append(allocations, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return allocations, nil
+}
+
+func (s *Storage) CountBerthAllocations(
+	ctx context.Context,
+	filter models.BerthAllocationFilter,
+) (int64, error) {
+	const op = "storage.postgresql.CountBerthAllocations"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM berth_allocation
+		WHERE ($1::integer IS NULL OR berth_id = $1)
+			AND ($2::integer IS NULL OR vessel_id = $2)
+			AND period && tstzrange($3::timestamptz, $4::timestamptz, '[)')
+	`, filter.BerthID, filter.VesselID, filter.From, filter.To).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+func (s *Storage) BerthAllocation(
+	ctx context.Context,
+	id int64,
+) (models.BerthAllocation, error) {
+	const op = "storage.postgresql.BerthAllocation"
+
+	var a models.BerthAllocation
+	err := s.pool.QueryRow(ctx, `
+		SELECT id, berth_id, vessel_id, port_call_id, lower(period), upper(period)
+		FROM berth_allocation
+		WHERE id = $1
+	`, id).Scan(&a.ID, &a.BerthID, &a.VesselID, &a.PortCallID, &a.StartsAt, &a.EndsAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BerthAllocation{}, fmt.Errorf("%s: %w", op, storage.ErrBerthAllocationNotFound)
+		}
+		return models.BerthAllocation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+// SaveBerthAllocation reserves the berth for the vessel. Overlapping
+// reservations are rejected by the exclusion constraints on the table.
+func (s *Storage) SaveBerthAllocation(
+	ctx context.Context,
+	allocation models.BerthAllocation,
+) (int64, error) {
+	const op = "storage.postgresql.SaveBerthAllocation"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkNotArchived(ctx, tx, "vessel", allocation.VesselID); err != nil {
+		if errors.Is(err, storage.ErrRelatedEntityNotFound) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO berth_allocation (berth_id, vessel_id, port_call_id, period)
+		SELECT b.id, v.id, $3, tstzrange($4, $5, '[)')
+		FROM berth b, vessel v
+		WHERE b.id = $1
+			AND v.id = $2
+			AND (cardinality(b.allowed_vessel_types) = 0
+				OR v.vessel_type = ANY(b.allowed_vessel_types))
+		RETURNING id
+	`, allocation.BerthID,
+		allocation.VesselID,
+		allocation.PortCallID,
+		allocation.StartsAt,
+		allocation.EndsAt,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch {
+			case pgErr.Code == "23P01" && pgErr.ConstraintName == "berth_allocation_vessel_excl":
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrVesselAllocationConflict)
+			case pgErr.Code == "23P01":
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrBerthAllocationConflict)
+			case pgErr.Code == "23503":
+				return 0, fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
+			}
+		}
+
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		var berthExists bool
+		err := tx.QueryRow(ctx, `
+			SELECT EXISTS(SELECT 1 FROM berth WHERE id = $1)
+		`, allocation.BerthID).Scan(&berthExists)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !berthExists {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrBerthNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrBerthVesselTypeNotAllowed)
+	}
+
+	if err := audit(ctx, tx, models.AuditBerthAllocation, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) DeleteBerthAllocation(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.DeleteBerthAllocation"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditBerthAllocation, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		DELETE FROM berth_allocation
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrBerthAllocationNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditBerthAllocation, models.AuditDelete, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// storageLocSelect selects storage locations together with the weight,
+// volume and number of cargos currently placed in them.
+const storageLocSelect = `
+		SELECT sl.id, sl.cargo_type_id, sl.max_weight, sl.max_volume,
+			u.used_weight, u.used_volume, u.cargo_count, sl.version
+		FROM storage_loc sl
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(c.weight), 0) AS used_weight,
+				COALESCE(SUM(c.volume), 0) AS used_volume,
+				COUNT(c.id) AS cargo_count
+			FROM storage_placement sp
+			JOIN cargo c ON c.id = sp.cargo_id
+			WHERE sp.storage_loc_id = sl.id AND sp.released_at IS NULL
+		) u`
+
+func (s *Storage) StorageLocations(
+	ctx context.Context,
+	afterID int64,
+	limit int,
+) ([]models.StorageLocation, error) {
+	const op = "storage.postgresql.StorageLocations"
+
+	rows, err := s.pool.Query(ctx, storageLocSelect+`
+		WHERE sl.id > $1
+		ORDER BY sl.id
+		LIMIT $2
+	`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var storageLocations []models.StorageLocation
+	for rows.Next() {
+		var sl models.StorageLocation
+		if err := rows.Scan(&sl.ID,
+			&sl.CargoTypeID,
+			&sl.MaxWeight,
+			&sl.MaxVolume,
+			&sl.UsedWeight,
+			&sl.UsedVolume,
+			&sl.CargoCount,
+			&sl.Version,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		storageLocations = append(storageLocations, sl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return storageLocations, nil
+}
+
+// SuitableStorageLocations returns the storage locations of the cargo type
+// that still have room for the given weight and volume.
+func (s *Storage) SuitableStorageLocations(
+	ctx context.Context,
+	cargoTypeID int64,
+	weight float64,
+	volume float64,
+) ([]models.StorageLocation, error) {
+	const op = "storage.postgresql.SuitableStorageLocations"
+
+	rows, err := s.pool.Query(ctx, storageLocSelect+`
+		WHERE sl.cargo_type_id = $1
+			AND sl.max_weight - u.used_weight >= $2
+			AND sl.max_volume - u.used_volume >= $3
+		ORDER BY sl.id
+	`, cargoTypeID, weight, volume)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var storageLocations []models.StorageLocation
+	for rows.Next() {
+		var sl models.StorageLocation
+		if err := rows.Scan(&sl.ID,
+			&sl.CargoTypeID,
+			&sl.MaxWeight,
+			&sl.MaxVolume,
+			&sl.UsedWeight,
+			&sl.UsedVolume,
+			&sl.CargoCount,
+			&sl.Version,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		storageLocations = append(storageLocations, sl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return storageLocations, nil
+}
+
+func (s *Storage) CountStorageLocations(
+	ctx context.Context,
+) (int64, error) {
+	const op = "storage.postgresql.CountStorageLocations"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM storage_loc
+	`).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+func (s *Storage) SaveStorageLoc(
+	ctx context.Context,
+	cargoTypeID int64,
+	maxWeight float64,
+	maxVolume float64,
+) (int64, error) {
+	const op = "storage.postgresql.SaveStorageLoc"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkNotArchived(ctx, tx, "cargo_type", cargoTypeID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO storage_loc (cargo_type_id, max_weight, max_volume)
+		VALUES($1, $2, $3)
+		RETURNING id
+	`, cargoTypeID, maxWeight, maxVolume).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditStorageLoc, models.AuditCreate, nil, id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) DeleteStorageLoc(
+	ctx context.Context,
+	id int64,
+) error {
+	const op = "storage.postgresql.DeleteStorageLoc"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockStorageLoc(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	before, err := snapshot(ctx, tx, models.AuditStorageLoc, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Closed placements keep referencing the location, so it can only be
+	// deleted before it has ever held a cargo.
+	var inUse, hasHistory bool
+	err = tx.QueryRow(ctx, `
+		SELECT
+			EXISTS (
+				SELECT 1 FROM storage_placement
+				WHERE storage_loc_id = $1 AND released_at IS NULL
+			),
+			EXISTS (
+				SELECT 1 FROM storage_placement
+				WHERE storage_loc_id = $1
+			)
+	`, id).Scan(&inUse, &hasHistory)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if inUse {
+		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocInUse)
+	}
+
+	if hasHistory {
+		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocHasHistory)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		DELETE FROM storage_loc
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, storage.ErrStorageLocHasHistory)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+	}
+
+	if err := audit(ctx, tx, models.AuditStorageLoc, models.AuditDelete, before, id); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if inUse {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocInUse)
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) StorageLocation(
+	ctx context.Context,
+	id int64,
+) (models.StorageLocation, error) {
+	const op = "storage.postgresql.StorageLocation"
+
+	var sl models.StorageLocation
+	err := s.pool.QueryRow(ctx, storageLocSelect+`
+		WHERE sl.id = $1
+	`, id).Scan(&sl.ID,
+		&sl.CargoTypeID,
+		&sl.MaxWeight,
+		&sl.MaxVolume,
+		&sl.UsedWeight,
+		&sl.UsedVolume,
+		&sl.CargoCount,
+		&sl.Version,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.StorageLocation{}, fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+		}
+
+		return models.StorageLocation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sl, nil
+}
+
+func (s *Storage) UpdateStorageLoc(
+	ctx context.Context,
+	id int64,
+	cargoTypeID *int64,
+	maxWeight *float64,
+	maxVolume *float64,
+	expectedVersion *int64,
+) error {
+	const op = "storage.postgresql.UpdateStorageLoc"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditStorageLoc, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var version int64
+	err = tx.QueryRow(ctx, `
+		SELECT version
+		FROM storage_loc
+		WHERE id = $1
+		FOR UPDATE
+	`, id).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkVersion(version, expectedVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cargoTypeID != nil {
+		if err := checkNotArchived(ctx, tx, "cargo_type", *cargoTypeID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// The location may only be retyped while empty, and its capacity
+	// may not drop below what is already placed in it.
+	cmdTag, err := tx.Exec(ctx, `
+		UPDATE storage_loc sl
+		SET cargo_type_id = COALESCE($1, sl.cargo_type_id),
+			max_weight = COALESCE($2, sl.max_weight),
+			max_volume = COALESCE($3, sl.max_volume),
+			version = sl.version + 1
+		FROM (
+			SELECT COALESCE(SUM(c.weight), 0) AS used_weight,
+				COALESCE(SUM(c.volume), 0) AS used_volume,
+				COUNT(c.id) AS cargo_count
+			FROM storage_placement sp
+			JOIN cargo c ON c.id = sp.cargo_id
+			WHERE sp.storage_loc_id = $4 AND sp.released_at IS NULL
+		) u
+		WHERE sl.id = $4
+			AND (u.cargo_count = 0 OR COALESCE($1, sl.cargo_type_id) = sl.cargo_type_id)
+			AND COALESCE($2, sl.max_weight) >= u.used_weight
+			AND COALESCE($3, sl.max_volume) >= u.used_volume
+	`, cargoTypeID, maxWeight, maxVolume, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// The row is locked and exists, so a miss means the new type or
+	// capacity does not fit what the location holds.
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocInUse)
+	}
+
+	if err := audit(ctx, tx, models.AuditStorageLoc, models.AuditUpdate, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) UseStorageLoc(
+	ctx context.Context,
+	storageLocID int64,
+	cargoID int64,
+	allowedFrom []models.CargoStatus,
+	operation models.Operation,
+) error {
+	const op = "storage.postgresql.UseStorageLoc"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Cargo rows are always locked before storage location rows so that
+	// placement, reset, move and auto placement cannot deadlock each other.
+	if err := lockCargo(ctx, tx, cargoID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := lockStorageLoc(ctx, tx, storageLocID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	before, err := snapshot(ctx, tx, models.AuditStorageLoc, storageLocID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var isCargoPlaced bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM storage_placement
+			WHERE cargo_id = $1 AND released_at IS NULL
+		)
+	`, cargoID).Scan(&isCargoPlaced)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if isCargoPlaced {
+		return fmt.Errorf("%s: %w", op, storage.ErrCargoAlreadyPlaced)
+	}
+
+	if err := placeCargo(ctx, tx, storageLocID, cargoID, operation.CreatedAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := setCargoStatus(ctx, tx, cargoID, allowedFrom, models.CargoInStorage); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := recordCargoOperation(ctx, tx, operation, cargoID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditStorageLoc, models.AuditUse, before, storageLocID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// lockCargo locks the cargo row until the end of the transaction.
+func lockCargo(ctx context.Context, tx pgx.Tx, id int64) error {
+	var lockedID int64
+	err := tx.QueryRow(ctx, `
+		SELECT id FROM cargo WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+	`, id).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrCargoNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// lockStorageLoc locks the storage location row until the end of the
+// transaction, serialising capacity checks against it.
+func lockStorageLoc(ctx context.Context, tx pgx.Tx, id int64) error {
+	var lockedID int64
+	err := tx.QueryRow(ctx, `
+		SELECT id FROM storage_loc WHERE id = $1 FOR UPDATE
+	`, id).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrStorageLocNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ResetStorageLoc releases the given cargo from the storage location,
+// or every cargo in it when cargoID is nil.
+func (s *Storage) ResetStorageLoc(
+	ctx context.Context,
+	id int64,
+	cargoID *int64,
+	allowedFrom []models.CargoStatus,
+	operation models.Operation,
+) error {
+	const op = "storage.postgresql.ResetStorageLoc"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT c.id
+		FROM cargo c
+		JOIN storage_placement sp ON sp.cargo_id = c.id
+		WHERE sp.storage_loc_id = $1
+			AND sp.released_at IS NULL
+			AND ($2::integer IS NULL OR c.id = $2)
+		ORDER BY c.id
+		FOR UPDATE OF c
+	`, id, cargoID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	locked, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := lockStorageLoc(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	before, err := snapshot(ctx, tx, models.AuditStorageLoc, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		UPDATE storage_placement
+		SET released_at = GREATEST($3, placed_at)
+		WHERE storage_loc_id = $1
+			AND released_at IS NULL
+			AND cargo_id = ANY($2)
+		RETURNING cargo_id
+	`, id, locked, operation.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	released, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(released) == 0 {
+		if cargoID != nil {
+			return fmt.Errorf("%s: %w", op, storage.ErrCargoNotPlaced)
+		}
+		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocAlreadyEmpty)
+	}
+
+	for _, releasedID := range released {
+		if err := setCargoStatus(ctx, tx, releasedID, allowedFrom, models.CargoReleased); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := recordCargoOperation(ctx, tx, operation, released...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := audit(ctx, tx, models.AuditStorageLoc, models.AuditReset, before, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// MoveCargo transfers a placed cargo to another storage location in one
+// transaction and returns the location it was taken from.
+func (s *Storage) MoveCargo(
+	ctx context.Context,
+	cargoID int64,
+	toStorageLocID int64,
+	operation models.Operation,
+) (int64, error) {
+	const op = "storage.postgresql.MoveCargo"
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCargo(ctx, tx, cargoID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	before, err := snapshot(ctx, tx, models.AuditCargo, cargoID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var fromStorageLocID int64
+	err = tx.QueryRow(ctx, `
+		SELECT storage_loc_id
+		FROM storage_placement
+		WHERE cargo_id = $1 AND released_at IS NULL
+	`, cargoID).Scan(&fromStorageLocID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrCargoNotPlaced)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if fromStorageLocID == toStorageLocID {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrCargoAlreadyPlaced)
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id
+		FROM storage_loc
+		WHERE id = ANY($1)
+		ORDER BY id
+		FOR UPDATE
+	`, []int64{fromStorageLocID, toStorageLocID})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	locked, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(locked) != 2 {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
 	}
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		DELETE FROM storage_loc
-		WHERE id = $1 AND cargo_id IS NULL AND date_of_placement IS NULL
-	`, id)
+	_, err = tx.Exec(ctx, `
+		UPDATE storage_placement
+		SET released_at = GREATEST($2, placed_at)
+		WHERE cargo_id = $1 AND released_at IS NULL
+	`, cargoID, operation.CreatedAt)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+	if err := placeCargo(ctx, tx, toStorageLocID, cargoID, operation.CreatedAt); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
-}
-
-func (s *Storage) StorageLocation(
-	ctx context.Context,
-	id int64,
-) (models.StorageLocation, error) {
-	const op = "storage.postgresql.StorageLocation"
+	if err := recordCargoOperation(ctx, tx, operation, cargoID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
 
-	var sl models.StorageLocation
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, cargo_type_id, max_weight, 
-			max_volume, cargo_id, date_of_placement
-		FROM storage_loc
-		WHERE id = $1
-	`, id).Scan(&sl.ID,
-		&sl.CargoTypeID,
-		&sl.MaxWeight,
-		&sl.CargoID,
-		&sl.DateOfPlacement,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return models.StorageLocation{}, fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
-		}
+	if err := audit(ctx, tx, models.AuditCargo, models.AuditMove, before, cargoID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
 
-		return models.StorageLocation{}, fmt.Errorf("%s: %w", op, err)
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return sl, nil
+	return fromStorageLocID, nil
 }
 
-func (s *Storage) UpdateStorageLoc(
+// placeCargo opens a placement of the cargo in the storage location if the
+// location takes its type and still has room for it. Must be called inside
+// a transaction.
+func placeCargo(
 	ctx context.Context,
-	id int64,
-	cargoTypeID *int64,
-	maxWeight *float64,
-	maxVolume *float64,
+	tx pgx.Tx,
+	storageLocID int64,
+	cargoID int64,
+	placedAt time.Time,
 ) error {
-	const op = "storage.postgresql.UpdateStorageLoc"
+	var isCargoType bool
+	err := tx.QueryRow(ctx, `
+		SELECT sl.cargo_type_id = c.type_id
+		FROM storage_loc sl
+		JOIN cargo c ON c.id = $2
+		WHERE sl.id = $1
+	`, storageLocID, cargoID).Scan(&isCargoType)
+	if err != nil {
+		return err
+	}
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		UPDATE storage_loc
-		SET cargo_type_id = COALESCE($1, cargo_type_id),
-			max_weight = COALESCE($2, max_weight),
-			max_volume = COALESCE($3, max_volume)
-		WHERE id = $4
-	`, cargoTypeID, maxWeight, maxVolume, id)
+	if !isCargoType {
+		return storage.ErrStorageLocTypeNotSuitable
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
+		INSERT INTO storage_placement (storage_loc_id, cargo_id, placed_at)
+		SELECT sl.id, c.id, $3
+		FROM storage_loc sl
+		JOIN cargo c ON c.id = $2
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(pc.weight), 0) AS used_weight,
+				COALESCE(SUM(pc.volume), 0) AS used_volume
+			FROM storage_placement sp
+			JOIN cargo pc ON pc.id = sp.cargo_id
+			WHERE sp.storage_loc_id = sl.id AND sp.released_at IS NULL
+		) u
+		WHERE sl.id = $1
+			AND u.used_weight + c.weight <= sl.max_weight
+			AND u.used_volume + c.volume <= sl.max_volume
+	`, storageLocID, cargoID, placedAt)
 	if err != nil {
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
-			return fmt.Errorf("%s: %w", op, storage.ErrRelatedEntityNotFound)
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return storage.ErrCargoAlreadyPlaced
 		}
-		return fmt.Errorf("%s: %w", op, err)
+		return err
 	}
 
 	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+		return storage.ErrStorageLocNotSuitable
 	}
 
 	return nil
 }
 
-func (s *Storage) UseStorageLoc(
+// AutoPlaceCargos places every unplaced cargo of the vessel whose status
+// allows it in one transaction. The cargos and the storage locations of
+// their types are locked while plan decides the assignment.
+func (s *Storage) AutoPlaceCargos(
 	ctx context.Context,
-	storageLocID int64,
-	cargoID int64,
-	date time.Time,
-) error {
-	const op = "storage.postgresql.UseStorageLoc"
+	vesselID int64,
+	allowedFrom []models.CargoStatus,
+	plan models.PlacementPlanner,
+	operation models.Operation,
+) (models.AutoPlacementResult, error) {
+	const op = "storage.postgresql.AutoPlaceCargos"
 
-	var isExist bool
-	err := s.pool.QueryRow(ctx, `
-		SELECT EXISTS (
-			SELECT 1 FROM storage_loc WHERE id = $1
-		)
-	`, storageLocID).Scan(&isExist)
+	tx, err := s.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback(ctx)
 
-	if !isExist {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+	if err := checkNotArchived(ctx, tx, "vessel", vesselID); err != nil {
+		if errors.Is(err, storage.ErrRelatedEntityNotFound) {
+			return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, storage.ErrVesselNotFound)
+		}
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var isOccupied bool
-	err = s.pool.QueryRow(ctx, `
-		SELECT EXISTS (
-			SELECT 1 FROM storage_loc WHERE id = $1 AND cargo_id IS NOT NULL
-		)
-	`, storageLocID).Scan(&isOccupied)
+	rows, err := tx.Query(ctx, `
+		SELECT c.id, c.title, c.type_id, c.weight, c.volume, c.vessel_id, c.port_call_id, c.status, c.version, c.deleted_at
+		FROM cargo c
+		WHERE c.vessel_id = $1
+			AND c.status = ANY($2::text[]::cargo_status[])
+			AND c.deleted_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM storage_placement sp
+				WHERE sp.cargo_id = c.id AND sp.released_at IS NULL
+			)
+		ORDER BY c.id
+		FOR UPDATE OF c
+	`, vesselID, allowedFrom)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if isOccupied {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocInUse)
+	cargos, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Cargo, error) {
+		var c models.Cargo
+		err := row.Scan(&c.ID,
+			&c.Title,
+			&c.TypeID,
+			&c.Weight,
+			&c.Volume,
+			&c.VesselID,
+			&c.PortCallID,
+			&c.Status,
+			&c.Version,
+			&c.DeletedAt)
+		return c, err
+	})
+	if err != nil {
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var cargoExists bool
-	err = s.pool.QueryRow(ctx, `
-        SELECT EXISTS (
-            SELECT 1 FROM cargo WHERE id = $1
-        )
-    `, cargoID).Scan(&cargoExists)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	if len(cargos) == 0 {
+		return models.AutoPlacementResult{}, nil
 	}
 
-	if !cargoExists {
-		return fmt.Errorf("%s: %w", op, storage.ErrCargoNotFound)
+	typeIDs := make([]int64, 0, len(cargos))
+	for _, c := range cargos {
+		typeIDs = append(typeIDs, c.TypeID)
 	}
 
-	var isCargoPlaced bool
-	err = s.pool.QueryRow(ctx, `
-		SELECT EXISTS (
-			SELECT 1 FROM storage_loc WHERE cargo_id = $1
-		)
-	`, cargoID).Scan(&isCargoPlaced)
+	_, err = tx.Exec(ctx, `
+		SELECT id
+		FROM storage_loc
+		WHERE cargo_type_id = ANY($1)
+		ORDER BY id
+		FOR UPDATE
+	`, typeIDs)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
-	if isCargoPlaced {
-		return fmt.Errorf("%s: %w", op, storage.ErrCargoAlreadyPlaced)
+
+	rows, err = tx.Query(ctx, storageLocSelect+`
+		WHERE sl.cargo_type_id = ANY($1)
+		ORDER BY sl.id
+	`, typeIDs)
+	if err != nil {
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var isCargoType bool
-	err = s.pool.QueryRow(ctx, `
-		SELECT sl.cargo_type_id = c.type_id
-		FROM storage_loc sl
-		JOIN cargo c ON c.id = $2
-		WHERE sl.id = $1
-	`, storageLocID, cargoID).Scan(&isCargoType)
+	locs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.StorageLocation, error) {
+		var sl models.StorageLocation
+		err := row.Scan(&sl.ID,
+			&sl.CargoTypeID,
+			&sl.MaxWeight,
+			&sl.MaxVolume,
+			&sl.UsedWeight,
+			&sl.UsedVolume,
+			&sl.CargoCount,
+			&sl.Version)
+		return sl, err
+	})
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
-	if !isCargoType {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocTypeNotSuitable)
+
+	result := plan(cargos, locs)
+	if len(result.Placed) == 0 {
+		return result, nil
 	}
 
-	tx, err := s.pool.Begin(ctx)
+	cargoIDs := make([]int64, 0, len(result.Placed))
+	locIDs := make([]int64, 0, len(result.Placed))
+	befores := make([]json.RawMessage, 0, len(result.Placed))
+	for _, a := range result.Placed {
+		cargoIDs = append(cargoIDs, a.CargoID)
+		locIDs = append(locIDs, a.StorageLocID)
+
+		before, err := snapshot(ctx, tx, models.AuditCargo, a.CargoID)
+		if err != nil {
+			return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
+		}
+		befores = append(befores, before)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO storage_placement (storage_loc_id, cargo_id, placed_at)
+		SELECT unnest($1::integer[]), unnest($2::integer[]), $3
+	`, locIDs, cargoIDs, operation.CreatedAt)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, storage.ErrCargoAlreadyPlaced)
+		}
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
-	defer tx.Rollback(ctx)
 
 	cmdTag, err := tx.Exec(ctx, `
-		UPDATE storage_loc sl
-		SET cargo_id = c.id,
-			date_of_placement = $3
-		FROM cargo c
-		WHERE 
-			sl.id = $1
-			AND c.id = $2
-			AND sl.cargo_id IS NULL
-			AND sl.cargo_type_id = c.type_id
-			AND sl.max_weight >= c.weight
-			AND sl.max_volume >= c.volume
-	`, storageLocID, cargoID, date)
+		UPDATE cargo
+		SET status = $3,
+			version = version + 1
+		WHERE id = ANY($1) AND status = ANY($2::text[]::cargo_status[])
+	`, cargoIDs, allowedFrom, models.CargoInStorage)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotSuitable)
+	if cmdTag.RowsAffected() != int64(len(cargoIDs)) {
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, storage.ErrCargoStatusTransitionNotAllowed)
 	}
 
-	var operationID int64
-	err = tx.QueryRow(ctx, `
-		INSERT INTO operation (title, created_at)
-		VALUES ('Размещение на складе', $1)
-		RETURNING id
-	`, date).Scan(&operationID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	if err := recordCargoOperation(ctx, tx, operation, cargoIDs...); err != nil {
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = tx.Exec(ctx, `
-		INSERT INTO operation_cargo (operation_id, cargo_id)
-		VALUES ($1, $2)
-	`, operationID, cargoID)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	for i, cargoID := range cargoIDs {
+		if err := audit(ctx, tx, models.AuditCargo, models.AuditAutoPlace, befores[i], cargoID); err != nil {
+			return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.AutoPlacementResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return result, nil
 }
 
-func (s *Storage) ResetStorageLoc(
+// StorageLocationContents returns the cargos currently placed in the
+// storage location, in order of placement.
+func (s *Storage) StorageLocationContents(
 	ctx context.Context,
 	id int64,
-) error {
-	const op = "storage.postgresql.ResetStorageLoc"
+	afterPlacementID int64,
+	limit int,
+) ([]models.StoredCargo, error) {
+	const op = "storage.postgresql.StorageLocationContents"
 
-	cmdTag, err := s.pool.Exec(ctx, `
-		UPDATE storage_loc
-		SET cargo_id = NULL,
-			date_of_placement = NULL
-		WHERE id = $1 AND cargo_id IS NOT NULL
-	`, id)
+	rows, err := s.pool.Query(ctx, `
+		SELECT sp.id, c.id, c.title, c.type_id, c.weight, c.volume, sp.placed_at
+		FROM storage_placement sp
+		JOIN cargo c ON c.id = sp.cargo_id
+		WHERE sp.storage_loc_id = $1
+			AND sp.released_at IS NULL
+			AND sp.id > $2
+		ORDER BY sp.id
+		LIMIT $3
+	`, id, afterPlacementID, limit)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
-	if cmdTag.RowsAffected() == 0 {
+	var contents []models.StoredCargo
+	for rows.Next() {
+		var sc models.StoredCargo
+		if err := rows.Scan(&sc.PlacementID,
+			&sc.CargoID,
+			&sc.Title,
+			&sc.TypeID,
+			&sc.Weight,
+			&sc.Volume,
+			&sc.PlacedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		contents = append(contents, sc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(contents) == 0 && afterPlacementID == 0 {
 		var exists bool
 		err := s.pool.QueryRow(ctx, `
-            SELECT EXISTS(SELECT 1 FROM storage_loc WHERE id = $1)
-        `, id).Scan(&exists)
-
+			SELECT EXISTS(SELECT 1 FROM storage_loc WHERE id = $1)
+		`, id).Scan(&exists)
 		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 
 		if !exists {
-			return fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrStorageLocNotFound)
 		}
+	}
 
-		return fmt.Errorf("%s: %w", op, storage.ErrStorageLocAlreadyEmpty)
+	return contents, nil
+}
+
+// StoragePlacements returns the placement history, optionally narrowed
+// to one storage location and/or one cargo.
+func (s *Storage) StoragePlacements(
+	ctx context.Context,
+	storageLocID *int64,
+	cargoID *int64,
+	afterID int64,
+	limit int,
+) ([]models.StoragePlacement, error) {
+	const op = "storage.postgresql.StoragePlacements"
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, storage_loc_id, cargo_id, placed_at, released_at
+		FROM storage_placement
+		WHERE id > $1
+			AND ($2::integer IS NULL OR storage_loc_id = $2)
+			AND ($3::integer IS NULL OR cargo_id = $3)
+		ORDER BY id
+		LIMIT $4
+	`, afterID, storageLocID, cargoID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
-	return nil
+	var placements []models.StoragePlacement
+	for rows.Next() {
+		var p models.StoragePlacement
+		if err := rows.Scan(&p.ID,
+			&p.StorageLocID,
+			&p.CargoID,
+			&p.PlacedAt,
+			&p.ReleasedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		placements = append(placements, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return placements, nil
+}
+
+func (s *Storage) CountStoragePlacements(
+	ctx context.Context,
+	storageLocID *int64,
+	cargoID *int64,
+) (int64, error) {
+	const op = "storage.postgresql.CountStoragePlacements"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM storage_placement
+		WHERE ($1::integer IS NULL OR storage_loc_id = $1)
+			AND ($2::integer IS NULL OR cargo_id = $2)
+	`, storageLocID, cargoID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
 }
 
 func (s *Storage) OperationsCargos(
 	ctx context.Context,
+	afterOperationID int64,
+	afterCargoID int64,
+	limit int,
 ) ([]models.OperationCargo, error) {
 	const op = "storage.postgresql.OperationsCargos"
 
 	rows, err := s.pool.Query(ctx, `
 		SELECT operation_id, cargo_id
 		FROM operation_cargo
-		ORDER BY operation_id
-	`)
+		WHERE (operation_id, cargo_id) > ($1, $2)
+		ORDER BY operation_id, cargo_id
+		LIMIT $3
+	`, afterOperationID, afterCargoID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -927,16 +3387,42 @@ func (s *Storage) OperationsCargos(
 		operCargos = append(operCargos, oc)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return operCargos, nil
 }
 
+func (s *Storage) CountOperationsCargos(
+	ctx context.Context,
+) (int64, error) {
+	const op = "storage.postgresql.CountOperationsCargos"
+
+	var count int64
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM operation_cargo
+	`).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
 func (s *Storage) SaveOperationCargo(
 	ctx context.Context,
 	operCargo models.OperationCargo,
 ) error {
 	const op = "storage.postgreql.SaveOperationCargo"
 
-	_, err := s.pool.Exec(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
 		INSERT INTO operation_cargo 
 			(operation_id, cargo_id)
 		VALUES ($1, $2)
@@ -955,6 +3441,14 @@ func (s *Storage) SaveOperationCargo(
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := audit(ctx, tx, models.AuditOperationCargo, models.AuditCreate, nil, operCargo.OperationID, operCargo.CargoID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -964,7 +3458,18 @@ func (s *Storage) DeleteOperationCargo(
 ) error {
 	const op = "storage.postgresql.DeleteOperCargo"
 
-	cmdTag, err := s.pool.Exec(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshot(ctx, tx, models.AuditOperationCargo, operCargo.OperationID, operCargo.CargoID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, `
 		DELETE FROM operation_cargo oc
 		WHERE oc.operation_id = $1 AND oc.cargo_id = $2
 	`, operCargo.OperationID, operCargo.CargoID)
@@ -976,6 +3481,14 @@ func (s *Storage) DeleteOperationCargo(
 		return fmt.Errorf("%s: %w", op, storage.ErrOperCargoNotFound)
 	}
 
+	if err := audit(ctx, tx, models.AuditOperationCargo, models.AuditDelete, before, operCargo.OperationID, operCargo.CargoID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -996,9 +3509,9 @@ func (s *Storage) CargoDetailReport(
         JOIN vessel v ON c.vessel_id = v.id
         JOIN operation_cargo oc ON c.id = oc.cargo_id
         JOIN operation o ON oc.operation_id = o.id
-        WHERE o.title = 'Выгрузка'
+        WHERE o.kind = $1
         ORDER BY o.created_at DESC
-    `)
+    `, models.OperationUnloading)
     
     if err != nil {
         return nil, fmt.Errorf("%s: %w", op, err)
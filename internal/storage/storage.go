@@ -5,11 +5,12 @@ import "errors"
 var (
 	ErrVesselExists = errors.New("vessel already exists")
 	ErrVesselNotFound = errors.New("vessel not found")
-	ErrVesselInUse = errors.New("vessel is used")
+	ErrVesselOverloaded = errors.New("vessel max load exceeded")
+	ErrVesselNotArchived = errors.New("vessel is not archived")
 
 	ErrCargoTypeExists = errors.New("cargo type already exists")
 	ErrCargoTypeNotFound = errors.New("cargo type not found")
-	ErrCargoTypeInUse = errors.New("cargo type is used")
+	ErrCargoTypeNotArchived = errors.New("cargo type is not archived")
 
 	ErrOperationExists = errors.New("operation already exists")
 	ErrOperationNotFound = errors.New("operation not found")
@@ -19,16 +20,36 @@ var (
 	ErrCargoExists = errors.New("cargo already exists")
 	ErrCargoInUse = errors.New("cargo is used")
 	ErrCargoAlreadyPlaced = errors.New("cargo is already placed in a storage location")
+	ErrCargoNotPlaced = errors.New("cargo is not placed in the storage location")
+	ErrCargoStatusTransitionNotAllowed = errors.New("cargo status transition not allowed")
+	ErrCargoNotArchived = errors.New("cargo is not archived")
+	ErrInvalidFilter = errors.New("invalid filter")
 
 	ErrStorageLocNotFound = errors.New("storage location not found")
 	ErrStorageLocInUse = errors.New("storage location is useed")
 	ErrStorageLocNotSuitable = errors.New("storage location not suitable")
 	ErrStorageLocTypeNotSuitable = errors.New("storage location type not suitable")
 	ErrStorageLocAlreadyEmpty = errors.New("storage location already empty")
+	ErrStorageLocHasHistory = errors.New("storage location has placement history")
 
 	ErrOperCargoAlreadyExist = errors.New("an operation with such cargo already exists")
 	ErrOperCargoNotFound = errors.New("an opearation with such cargo not found")
 
+	ErrPortCallNotFound = errors.New("port call not found")
+	ErrPortCallExists = errors.New("port call already exists")
+	ErrPortCallInUse = errors.New("port call is used")
+	ErrPortCallStatusConflict = errors.New("port call status does not allow this action")
+
+	ErrBerthNotFound = errors.New("berth not found")
+	ErrBerthExists = errors.New("berth already exists")
+	ErrBerthInUse = errors.New("berth is used")
+	ErrBerthVesselTypeNotAllowed = errors.New("vessel type is not allowed at the berth")
+
+	ErrBerthAllocationNotFound = errors.New("berth allocation not found")
+	ErrBerthAllocationConflict = errors.New("berth is already allocated for this period")
+	ErrVesselAllocationConflict = errors.New("vessel is already allocated to a berth for this period")
+
 	ErrRelatedEntityNotFound = errors.New("related entity not found")
-	ErrForeignKeyViolation = errors.New("foreign key violation")
+
+	ErrConcurrentModification = errors.New("entity was modified concurrently")
 )
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS storage_loc_cargo_id_idx;
+DROP INDEX IF EXISTS cargo_vessel_id_idx;
+DROP INDEX IF EXISTS cargo_type_id_idx;
\ No newline at end of file
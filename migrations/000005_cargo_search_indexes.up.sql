@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS cargo_type_id_idx ON cargo (type_id);
+CREATE INDEX IF NOT EXISTS cargo_vessel_id_idx ON cargo (vessel_id);
+CREATE INDEX IF NOT EXISTS storage_loc_cargo_id_idx ON storage_loc (cargo_id);
\ No newline at end of file
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS cargo_port_call_id_idx;
+
+ALTER TABLE cargo
+DROP CONSTRAINT cargo_port_call_fk;
+
+ALTER TABLE cargo
+DROP COLUMN port_call_id;
+
+DROP TABLE IF EXISTS port_call;
\ No newline at end of file
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS port_call (
+    id SERIAL PRIMARY KEY,
+    vessel_id INTEGER NOT NULL REFERENCES vessel(id),
+    voyage_number VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'SCHEDULED'
+        CHECK (status IN ('SCHEDULED', 'ARRIVED', 'DEPARTED', 'CANCELLED')),
+    eta TIMESTAMP NOT NULL,
+    etd TIMESTAMP NOT NULL,
+    ata TIMESTAMP,
+    atd TIMESTAMP,
+    CONSTRAINT port_call_etd_chk CHECK (etd >= eta),
+    CONSTRAINT port_call_atd_chk CHECK (atd IS NULL OR (ata IS NOT NULL AND atd >= ata)),
+    CONSTRAINT port_call_voyage_uq UNIQUE (vessel_id, voyage_number),
+    CONSTRAINT port_call_id_vessel_uq UNIQUE (id, vessel_id)
+);
+
+ALTER TABLE cargo
+ADD COLUMN port_call_id INTEGER;
+
+ALTER TABLE cargo
+ADD CONSTRAINT cargo_port_call_fk
+FOREIGN KEY (port_call_id, vessel_id) REFERENCES port_call(id, vessel_id);
+
+CREATE INDEX IF NOT EXISTS cargo_port_call_id_idx ON cargo (port_call_id);
\ No newline at end of file
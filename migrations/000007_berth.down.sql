@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS berth_allocation;
+DROP TABLE IF EXISTS berth;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE IF NOT EXISTS berth (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(200) NOT NULL,
+    length DECIMAL(10, 2) NOT NULL CHECK (length > 0),
+    max_draft DECIMAL(10, 2) NOT NULL CHECK (max_draft > 0),
+    allowed_vessel_types VARCHAR(200)[] NOT NULL DEFAULT '{}',
+    CONSTRAINT berth_title_uq UNIQUE (title)
+);
+
+CREATE TABLE IF NOT EXISTS berth_allocation (
+    id SERIAL PRIMARY KEY,
+    berth_id INTEGER NOT NULL REFERENCES berth(id),
+    vessel_id INTEGER NOT NULL REFERENCES vessel(id),
+    port_call_id INTEGER,
+    period TSTZRANGE NOT NULL
+        CHECK (NOT isempty(period) AND NOT lower_inf(period) AND NOT upper_inf(period)),
+    CONSTRAINT berth_allocation_port_call_fk
+        FOREIGN KEY (port_call_id, vessel_id) REFERENCES port_call(id, vessel_id),
+    CONSTRAINT berth_allocation_berth_excl
+        EXCLUDE USING gist (berth_id WITH =, period WITH &&),
+    CONSTRAINT berth_allocation_vessel_excl
+        EXCLUDE USING gist (vessel_id WITH =, period WITH &&)
+);
\ No newline at end of file
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS operation_kind_idx;
+
+ALTER TABLE operation
+DROP COLUMN kind;
+
+DROP TYPE IF EXISTS operation_kind;
\ No newline at end of file
@@ -0,0 +1,38 @@
+CREATE TYPE operation_kind AS ENUM (
+    'UNLOADING',
+    'LOADING',
+    'PLACEMENT',
+    'RELEASE',
+    'TRANSFER',
+    'INSPECTION',
+    'ARRIVAL',
+    'DEPARTURE',
+    'CUSTOMS_CLEARANCE',
+    'OTHER'
+);
+
+ALTER TABLE operation
+ADD COLUMN kind operation_kind NOT NULL DEFAULT 'OTHER';
+
+UPDATE operation
+SET kind = CASE
+    WHEN lower(title) LIKE 'выгрузка%' THEN 'UNLOADING'
+    WHEN lower(title) LIKE 'разгрузка%' THEN 'UNLOADING'
+    WHEN lower(title) LIKE 'погрузка%' THEN 'LOADING'
+    WHEN lower(title) LIKE 'загрузка%' THEN 'LOADING'
+    WHEN lower(title) LIKE 'размещение%' THEN 'PLACEMENT'
+    WHEN lower(title) LIKE 'выдача%' THEN 'RELEASE'
+    WHEN lower(title) LIKE 'освобождение%' THEN 'RELEASE'
+    WHEN lower(title) LIKE 'перемещение%' THEN 'TRANSFER'
+    WHEN lower(title) LIKE 'досмотр%' THEN 'INSPECTION'
+    WHEN lower(title) LIKE 'осмотр%' THEN 'INSPECTION'
+    WHEN lower(title) LIKE 'инспекция%' THEN 'INSPECTION'
+    WHEN lower(title) LIKE 'прибытие%' THEN 'ARRIVAL'
+    WHEN lower(title) LIKE 'швартовка%' THEN 'ARRIVAL'
+    WHEN lower(title) LIKE 'отправление%' THEN 'DEPARTURE'
+    WHEN lower(title) LIKE 'убытие%' THEN 'DEPARTURE'
+    WHEN lower(title) LIKE 'таможен%' THEN 'CUSTOMS_CLEARANCE'
+    ELSE 'OTHER'
+END::operation_kind;
+
+CREATE INDEX IF NOT EXISTS operation_kind_idx ON operation (kind);
\ No newline at end of file
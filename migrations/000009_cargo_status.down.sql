@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS cargo_status_idx;
+
+ALTER TABLE cargo
+DROP COLUMN status;
\ No newline at end of file
@@ -0,0 +1,20 @@
+ALTER TABLE cargo
+ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'EXPECTED'
+    CHECK (status IN ('EXPECTED', 'ON_VESSEL', 'UNLOADED', 'IN_STORAGE', 'RELEASED', 'LOADED', 'DEPARTED'));
+
+UPDATE cargo c
+SET status = CASE
+    WHEN EXISTS (SELECT 1 FROM storage_loc sl WHERE sl.cargo_id = c.id) THEN 'IN_STORAGE'
+    WHEN EXISTS (
+        SELECT 1
+        FROM operation_cargo oc
+        JOIN operation o ON o.id = oc.operation_id
+        WHERE oc.cargo_id = c.id AND o.kind = 'UNLOADING'
+    ) THEN 'UNLOADED'
+    WHEN EXISTS (
+        SELECT 1 FROM port_call pc WHERE pc.id = c.port_call_id AND pc.status = 'ARRIVED'
+    ) THEN 'ON_VESSEL'
+    ELSE 'EXPECTED'
+END;
+
+CREATE INDEX IF NOT EXISTS cargo_status_idx ON cargo (status);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS storage_placement;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS storage_placement (
+    id SERIAL PRIMARY KEY,
+    storage_loc_id INTEGER NOT NULL REFERENCES storage_loc(id),
+    cargo_id INTEGER NOT NULL REFERENCES cargo(id),
+    placed_at TIMESTAMP NOT NULL,
+    released_at TIMESTAMP,
+    CONSTRAINT storage_placement_released_chk CHECK (released_at IS NULL OR released_at >= placed_at)
+);
+
+CREATE INDEX IF NOT EXISTS storage_placement_storage_loc_id_idx ON storage_placement (storage_loc_id);
+CREATE INDEX IF NOT EXISTS storage_placement_cargo_id_idx ON storage_placement (cargo_id);
+
+INSERT INTO storage_placement (storage_loc_id, cargo_id, placed_at)
+SELECT id, cargo_id, COALESCE(date_of_placement, CURRENT_TIMESTAMP)
+FROM storage_loc
+WHERE cargo_id IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS storage_placement_open_idx;
+
+ALTER TABLE storage_loc
+ADD COLUMN cargo_id INTEGER REFERENCES cargo(id),
+ADD COLUMN date_of_placement TIMESTAMP;
+
+-- Only one cargo fits the old layout; keep the earliest open placement.
+UPDATE storage_loc sl
+SET cargo_id = sp.cargo_id,
+    date_of_placement = sp.placed_at
+FROM (
+    SELECT DISTINCT ON (storage_loc_id) storage_loc_id, cargo_id, placed_at
+    FROM storage_placement
+    WHERE released_at IS NULL
+    ORDER BY storage_loc_id, placed_at, id
+) sp
+WHERE sp.storage_loc_id = sl.id;
\ No newline at end of file
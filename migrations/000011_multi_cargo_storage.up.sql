@@ -0,0 +1,20 @@
+-- Current contents already live in storage_placement as open rows,
+-- so the single-cargo columns can go.
+INSERT INTO storage_placement (storage_loc_id, cargo_id, placed_at)
+SELECT sl.id, sl.cargo_id, COALESCE(sl.date_of_placement, CURRENT_TIMESTAMP)
+FROM storage_loc sl
+WHERE sl.cargo_id IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM storage_placement sp
+        WHERE sp.storage_loc_id = sl.id
+            AND sp.cargo_id = sl.cargo_id
+            AND sp.released_at IS NULL
+    );
+
+ALTER TABLE storage_loc
+DROP COLUMN cargo_id,
+DROP COLUMN date_of_placement;
+
+CREATE INDEX IF NOT EXISTS storage_placement_open_idx
+ON storage_placement (storage_loc_id)
+WHERE released_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS storage_placement_cargo_open_uq;
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE UNIQUE INDEX IF NOT EXISTS storage_placement_cargo_open_uq
+ON storage_placement (cargo_id)
+WHERE released_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE operation
+DROP COLUMN version;
+
+ALTER TABLE storage_loc
+DROP COLUMN version;
+
+ALTER TABLE cargo
+DROP COLUMN version;
+
+ALTER TABLE cargo_type
+DROP COLUMN version;
+
+ALTER TABLE vessel
+DROP COLUMN version;
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE vessel
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE cargo_type
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE cargo
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE storage_loc
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE operation
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
\ No newline at end of file
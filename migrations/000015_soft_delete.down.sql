@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS cargo_type_title_uq;
+
+ALTER TABLE cargo_type
+ADD CONSTRAINT cargo_type_title_uq UNIQUE (title);
+
+DROP INDEX IF EXISTS vessel_title_uq;
+
+ALTER TABLE vessel
+ADD CONSTRAINT vessel_title_uq UNIQUE (title);
+
+ALTER TABLE cargo
+DROP COLUMN deleted_at;
+
+ALTER TABLE cargo_type
+DROP COLUMN deleted_at;
+
+ALTER TABLE vessel
+DROP COLUMN deleted_at;
\ No newline at end of file
@@ -0,0 +1,22 @@
+ALTER TABLE vessel
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE cargo_type
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE cargo
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE vessel
+DROP CONSTRAINT vessel_title_uq;
+
+CREATE UNIQUE INDEX IF NOT EXISTS vessel_title_uq
+ON vessel (title)
+WHERE deleted_at IS NULL;
+
+ALTER TABLE cargo_type
+DROP CONSTRAINT cargo_type_title_uq;
+
+CREATE UNIQUE INDEX IF NOT EXISTS cargo_type_title_uq
+ON cargo_type (title)
+WHERE deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS storage_placement_placed_at_idx;
\ No newline at end of file
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS storage_placement_placed_at_idx
+ON storage_placement (placed_at);
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE audit_log
+DROP COLUMN IF EXISTS claimed_actor;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE audit_log
+ADD COLUMN claimed_actor VARCHAR(200);
\ No newline at end of file
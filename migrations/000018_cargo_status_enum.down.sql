@@ -0,0 +1,10 @@
+ALTER TABLE cargo
+ALTER COLUMN status DROP DEFAULT,
+ALTER COLUMN status TYPE VARCHAR(20) USING status::text,
+ALTER COLUMN status SET DEFAULT 'EXPECTED';
+
+ALTER TABLE cargo
+ADD CONSTRAINT cargo_status_check
+    CHECK (status IN ('EXPECTED', 'ON_VESSEL', 'UNLOADED', 'IN_STORAGE', 'RELEASED', 'LOADED', 'DEPARTED'));
+
+DROP TYPE IF EXISTS cargo_status;
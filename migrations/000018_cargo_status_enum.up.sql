@@ -0,0 +1,17 @@
+CREATE TYPE cargo_status AS ENUM (
+    'EXPECTED',
+    'ON_VESSEL',
+    'UNLOADED',
+    'IN_STORAGE',
+    'RELEASED',
+    'LOADED',
+    'DEPARTED'
+);
+
+ALTER TABLE cargo
+DROP CONSTRAINT IF EXISTS cargo_status_check;
+
+ALTER TABLE cargo
+ALTER COLUMN status DROP DEFAULT,
+ALTER COLUMN status TYPE cargo_status USING status::cargo_status,
+ALTER COLUMN status SET DEFAULT 'EXPECTED';
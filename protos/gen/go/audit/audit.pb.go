@@ -0,0 +1,571 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: audit/audit.proto
+
+package auditv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EntityType int32
+
+const (
+	EntityType_ENTITY_TYPE_UNSPECIFIED      EntityType = 0
+	EntityType_ENTITY_TYPE_VESSEL           EntityType = 1
+	EntityType_ENTITY_TYPE_CARGO_TYPE       EntityType = 2
+	EntityType_ENTITY_TYPE_CARGO            EntityType = 3
+	EntityType_ENTITY_TYPE_OPERATION        EntityType = 4
+	EntityType_ENTITY_TYPE_OPERATION_CARGO  EntityType = 5
+	EntityType_ENTITY_TYPE_STORAGE_LOCATION EntityType = 6
+	EntityType_ENTITY_TYPE_PORT_CALL        EntityType = 7
+	EntityType_ENTITY_TYPE_BERTH            EntityType = 8
+	EntityType_ENTITY_TYPE_BERTH_ALLOCATION EntityType = 9
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_VESSEL",
+		2: "ENTITY_TYPE_CARGO_TYPE",
+		3: "ENTITY_TYPE_CARGO",
+		4: "ENTITY_TYPE_OPERATION",
+		5: "ENTITY_TYPE_OPERATION_CARGO",
+		6: "ENTITY_TYPE_STORAGE_LOCATION",
+		7: "ENTITY_TYPE_PORT_CALL",
+		8: "ENTITY_TYPE_BERTH",
+		9: "ENTITY_TYPE_BERTH_ALLOCATION",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED":      0,
+		"ENTITY_TYPE_VESSEL":           1,
+		"ENTITY_TYPE_CARGO_TYPE":       2,
+		"ENTITY_TYPE_CARGO":            3,
+		"ENTITY_TYPE_OPERATION":        4,
+		"ENTITY_TYPE_OPERATION_CARGO":  5,
+		"ENTITY_TYPE_STORAGE_LOCATION": 6,
+		"ENTITY_TYPE_PORT_CALL":        7,
+		"ENTITY_TYPE_BERTH":            8,
+		"ENTITY_TYPE_BERTH_ALLOCATION": 9,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_audit_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_audit_audit_proto_enumTypes[0]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{0}
+}
+
+type Action int32
+
+const (
+	Action_ACTION_UNSPECIFIED Action = 0
+	Action_ACTION_CREATE      Action = 1
+	Action_ACTION_UPDATE      Action = 2
+	Action_ACTION_DELETE      Action = 3
+	Action_ACTION_USE         Action = 4
+	Action_ACTION_RESET       Action = 5
+	Action_ACTION_MOVE        Action = 6
+	Action_ACTION_AUTO_PLACE  Action = 7
+	Action_ACTION_TRANSITION  Action = 8
+	Action_ACTION_RESTORE     Action = 9
+)
+
+// Enum value maps for Action.
+var (
+	Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_CREATE",
+		2: "ACTION_UPDATE",
+		3: "ACTION_DELETE",
+		4: "ACTION_USE",
+		5: "ACTION_RESET",
+		6: "ACTION_MOVE",
+		7: "ACTION_AUTO_PLACE",
+		8: "ACTION_TRANSITION",
+		9: "ACTION_RESTORE",
+	}
+	Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_CREATE":      1,
+		"ACTION_UPDATE":      2,
+		"ACTION_DELETE":      3,
+		"ACTION_USE":         4,
+		"ACTION_RESET":       5,
+		"ACTION_MOVE":        6,
+		"ACTION_AUTO_PLACE":  7,
+		"ACTION_TRANSITION":  8,
+		"ACTION_RESTORE":     9,
+	}
+)
+
+func (x Action) Enum() *Action {
+	p := new(Action)
+	*p = x
+	return p
+}
+
+func (x Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_audit_audit_proto_enumTypes[1].Descriptor()
+}
+
+func (Action) Type() protoreflect.EnumType {
+	return &file_audit_audit_proto_enumTypes[1]
+}
+
+func (x Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Action.Descriptor instead.
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{1}
+}
+
+// before and after hold JSON snapshots of the entity and are empty
+// when it did not exist on that side of the change. claimed_actor is the
+// unverified x-actor header of a call made with authentication off;
+// actor is "anonymous" then.
+type AuditRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType    EntityType             `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=auditv1.EntityType" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Action        Action                 `protobuf:"varint,4,opt,name=action,proto3,enum=auditv1.Action" json:"action,omitempty"`
+	Before        string                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	Actor         string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=at,proto3" json:"at,omitempty"`
+	ClaimedActor  *string                `protobuf:"bytes,9,opt,name=claimed_actor,json=claimedActor,proto3,oneof" json:"claimed_actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_audit_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetEntityType() EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *AuditRecord) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAction() Action {
+	if x != nil {
+		return x.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (x *AuditRecord) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetClaimedActor() string {
+	if x != nil && x.ClaimedActor != nil {
+		return *x.ClaimedActor
+	}
+	return ""
+}
+
+// entity_id is the id of the entity, or "operation_id:cargo_id" for
+// operation cargos. Records are returned newest first.
+type QueryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	EntityType     EntityType             `protobuf:"varint,4,opt,name=entity_type,json=entityType,proto3,enum=auditv1.EntityType" json:"entity_type,omitempty"`
+	EntityId       *string                `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`
+	Actor          *string                `protobuf:"bytes,6,opt,name=actor,proto3,oneof" json:"actor,omitempty"`
+	From           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	mi := &file_audit_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *QueryRequest) GetEntityType() EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *QueryRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *QueryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AuditRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	mi := &file_audit_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryResponse) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+var File_audit_audit_proto protoreflect.FileDescriptor
+
+const file_audit_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x11audit/audit.proto\x12\aauditv1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x02\n" +
+	"\vAuditRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x124\n" +
+	"\ventity_type\x18\x02 \x01(\x0e2\x13.auditv1.EntityTypeR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12'\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x0f.auditv1.ActionR\x06action\x12\x16\n" +
+	"\x06before\x18\x05 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x06 \x01(\tR\x05after\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12*\n" +
+	"\x02at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12(\n" +
+	"\rclaimed_actor\x18\t \x01(\tH\x00R\fclaimedActor\x88\x01\x01B\x10\n" +
+	"\x0e_claimed_actor\"\xf5\x02\n" +
+	"\fQueryRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\x124\n" +
+	"\ventity_type\x18\x04 \x01(\x0e2\x13.auditv1.EntityTypeR\n" +
+	"entityType\x12 \n" +
+	"\tentity_id\x18\x05 \x01(\tH\x00R\bentityId\x88\x01\x01\x12\x19\n" +
+	"\x05actor\x18\x06 \x01(\tH\x01R\x05actor\x88\x01\x01\x123\n" +
+	"\x04from\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x02to\x88\x01\x01B\f\n" +
+	"\n" +
+	"_entity_idB\b\n" +
+	"\x06_actorB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\x9d\x01\n" +
+	"\rQueryResponse\x12.\n" +
+	"\arecords\x18\x01 \x03(\v2\x14.auditv1.AuditRecordR\arecords\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count*\xa6\x02\n" +
+	"\n" +
+	"EntityType\x12\x1b\n" +
+	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ENTITY_TYPE_VESSEL\x10\x01\x12\x1a\n" +
+	"\x16ENTITY_TYPE_CARGO_TYPE\x10\x02\x12\x15\n" +
+	"\x11ENTITY_TYPE_CARGO\x10\x03\x12\x19\n" +
+	"\x15ENTITY_TYPE_OPERATION\x10\x04\x12\x1f\n" +
+	"\x1bENTITY_TYPE_OPERATION_CARGO\x10\x05\x12 \n" +
+	"\x1cENTITY_TYPE_STORAGE_LOCATION\x10\x06\x12\x19\n" +
+	"\x15ENTITY_TYPE_PORT_CALL\x10\a\x12\x15\n" +
+	"\x11ENTITY_TYPE_BERTH\x10\b\x12 \n" +
+	"\x1cENTITY_TYPE_BERTH_ALLOCATION\x10\t*\xce\x01\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rACTION_CREATE\x10\x01\x12\x11\n" +
+	"\rACTION_UPDATE\x10\x02\x12\x11\n" +
+	"\rACTION_DELETE\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ACTION_USE\x10\x04\x12\x10\n" +
+	"\fACTION_RESET\x10\x05\x12\x0f\n" +
+	"\vACTION_MOVE\x10\x06\x12\x15\n" +
+	"\x11ACTION_AUTO_PLACE\x10\a\x12\x15\n" +
+	"\x11ACTION_TRANSITION\x10\b\x12\x12\n" +
+	"\x0eACTION_RESTORE\x10\t2a\n" +
+	"\fAuditService\x12Q\n" +
+	"\x05Query\x12\x15.auditv1.QueryRequest\x1a\x16.auditv1.QueryResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/audit-recordsB7Z5github.com/deadsnxcks/dbcp/protos/proto/audit;auditv1b\x06proto3"
+
+var (
+	file_audit_audit_proto_rawDescOnce sync.Once
+	file_audit_audit_proto_rawDescData []byte
+)
+
+func file_audit_audit_proto_rawDescGZIP() []byte {
+	file_audit_audit_proto_rawDescOnce.Do(func() {
+		file_audit_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_audit_proto_rawDesc), len(file_audit_audit_proto_rawDesc)))
+	})
+	return file_audit_audit_proto_rawDescData
+}
+
+var file_audit_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_audit_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_audit_audit_proto_goTypes = []any{
+	(EntityType)(0),               // 0: auditv1.EntityType
+	(Action)(0),                   // 1: auditv1.Action
+	(*AuditRecord)(nil),           // 2: auditv1.AuditRecord
+	(*QueryRequest)(nil),          // 3: auditv1.QueryRequest
+	(*QueryResponse)(nil),         // 4: auditv1.QueryResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_audit_audit_proto_depIdxs = []int32{
+	0, // 0: auditv1.AuditRecord.entity_type:type_name -> auditv1.EntityType
+	1, // 1: auditv1.AuditRecord.action:type_name -> auditv1.Action
+	5, // 2: auditv1.AuditRecord.at:type_name -> google.protobuf.Timestamp
+	0, // 3: auditv1.QueryRequest.entity_type:type_name -> auditv1.EntityType
+	5, // 4: auditv1.QueryRequest.from:type_name -> google.protobuf.Timestamp
+	5, // 5: auditv1.QueryRequest.to:type_name -> google.protobuf.Timestamp
+	2, // 6: auditv1.QueryResponse.records:type_name -> auditv1.AuditRecord
+	3, // 7: auditv1.AuditService.Query:input_type -> auditv1.QueryRequest
+	4, // 8: auditv1.AuditService.Query:output_type -> auditv1.QueryResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_audit_audit_proto_init() }
+func file_audit_audit_proto_init() {
+	if File_audit_audit_proto != nil {
+		return
+	}
+	file_audit_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_audit_audit_proto_msgTypes[1].OneofWrappers = []any{}
+	file_audit_audit_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_audit_proto_rawDesc), len(file_audit_audit_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_audit_audit_proto_goTypes,
+		DependencyIndexes: file_audit_audit_proto_depIdxs,
+		EnumInfos:         file_audit_audit_proto_enumTypes,
+		MessageInfos:      file_audit_audit_proto_msgTypes,
+	}.Build()
+	File_audit_audit_proto = out.File
+	file_audit_audit_proto_goTypes = nil
+	file_audit_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,789 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: berth/berth.proto
+
+package berthv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Berth struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Length             float64                `protobuf:"fixed64,3,opt,name=length,proto3" json:"length,omitempty"`
+	MaxDraft           float64                `protobuf:"fixed64,4,opt,name=max_draft,json=maxDraft,proto3" json:"max_draft,omitempty"`
+	AllowedVesselTypes []string               `protobuf:"bytes,5,rep,name=allowed_vessel_types,json=allowedVesselTypes,proto3" json:"allowed_vessel_types,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Berth) Reset() {
+	*x = Berth{}
+	mi := &file_berth_berth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Berth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Berth) ProtoMessage() {}
+
+func (x *Berth) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Berth.ProtoReflect.Descriptor instead.
+func (*Berth) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Berth) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Berth) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Berth) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Berth) GetMaxDraft() float64 {
+	if x != nil {
+		return x.MaxDraft
+	}
+	return 0
+}
+
+func (x *Berth) GetAllowedVesselTypes() []string {
+	if x != nil {
+		return x.AllowedVesselTypes
+	}
+	return nil
+}
+
+type ListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_berth_berth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Berths        []*Berth               `protobuf:"bytes,1,rep,name=berths,proto3" json:"berths,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_berth_berth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListResponse) GetBerths() []*Berth {
+	if x != nil {
+		return x.Berths
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_berth_berth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Berth         *Berth                 `protobuf:"bytes,1,opt,name=berth,proto3" json:"berth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_berth_berth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetResponse) GetBerth() *Berth {
+	if x != nil {
+		return x.Berth
+	}
+	return nil
+}
+
+type CreateRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Title              string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Length             float64                `protobuf:"fixed64,2,opt,name=length,proto3" json:"length,omitempty"`
+	MaxDraft           float64                `protobuf:"fixed64,3,opt,name=max_draft,json=maxDraft,proto3" json:"max_draft,omitempty"`
+	AllowedVesselTypes []string               `protobuf:"bytes,4,rep,name=allowed_vessel_types,json=allowedVesselTypes,proto3" json:"allowed_vessel_types,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	mi := &file_berth_berth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetMaxDraft() float64 {
+	if x != nil {
+		return x.MaxDraft
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetAllowedVesselTypes() []string {
+	if x != nil {
+		return x.AllowedVesselTypes
+	}
+	return nil
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	mi := &file_berth_berth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VesselTypes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VesselTypes) Reset() {
+	*x = VesselTypes{}
+	mi := &file_berth_berth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VesselTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VesselTypes) ProtoMessage() {}
+
+func (x *VesselTypes) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VesselTypes.ProtoReflect.Descriptor instead.
+func (*VesselTypes) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VesselTypes) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type UpdateRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Length   *float64               `protobuf:"fixed64,3,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	MaxDraft *float64               `protobuf:"fixed64,4,opt,name=max_draft,json=maxDraft,proto3,oneof" json:"max_draft,omitempty"`
+	// Replaces the whole list when set; an empty list allows any vessel type.
+	AllowedVesselTypes *VesselTypes `protobuf:"bytes,5,opt,name=allowed_vessel_types,json=allowedVesselTypes,proto3" json:"allowed_vessel_types,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_berth_berth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetLength() float64 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetMaxDraft() float64 {
+	if x != nil && x.MaxDraft != nil {
+		return *x.MaxDraft
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetAllowedVesselTypes() *VesselTypes {
+	if x != nil {
+		return x.AllowedVesselTypes
+	}
+	return nil
+}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	mi := &file_berth_berth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{9}
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_berth_berth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_berth_berth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berth_berth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_berth_berth_proto_rawDescGZIP(), []int{11}
+}
+
+var File_berth_berth_proto protoreflect.FileDescriptor
+
+const file_berth_berth_proto_rawDesc = "" +
+	"\n" +
+	"\x11berth/berth.proto\x12\aberthv1\x1a\x1cgoogle/api/annotations.proto\"\x94\x01\n" +
+	"\x05Berth\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x01R\x06length\x12\x1b\n" +
+	"\tmax_draft\x18\x04 \x01(\x01R\bmaxDraft\x120\n" +
+	"\x14allowed_vessel_types\x18\x05 \x03(\tR\x12allowedVesselTypes\"s\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\"\x94\x01\n" +
+	"\fListResponse\x12&\n" +
+	"\x06berths\x18\x01 \x03(\v2\x0e.berthv1.BerthR\x06berths\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x1c\n" +
+	"\n" +
+	"GetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\vGetResponse\x12$\n" +
+	"\x05berth\x18\x01 \x01(\v2\x0e.berthv1.BerthR\x05berth\"\x8c\x01\n" +
+	"\rCreateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x01R\x06length\x12\x1b\n" +
+	"\tmax_draft\x18\x03 \x01(\x01R\bmaxDraft\x120\n" +
+	"\x14allowed_vessel_types\x18\x04 \x03(\tR\x12allowedVesselTypes\" \n" +
+	"\x0eCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"%\n" +
+	"\vVesselTypes\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xe4\x01\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1b\n" +
+	"\x06length\x18\x03 \x01(\x01H\x01R\x06length\x88\x01\x01\x12 \n" +
+	"\tmax_draft\x18\x04 \x01(\x01H\x02R\bmaxDraft\x88\x01\x01\x12F\n" +
+	"\x14allowed_vessel_types\x18\x05 \x01(\v2\x14.berthv1.VesselTypesR\x12allowedVesselTypesB\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_lengthB\f\n" +
+	"\n" +
+	"_max_draft\"\x10\n" +
+	"\x0eUpdateResponse\"\x1f\n" +
+	"\rDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
+	"\x0eDeleteResponse2\x9f\x03\n" +
+	"\fBerthService\x12G\n" +
+	"\x04List\x12\x14.berthv1.ListRequest\x1a\x15.berthv1.ListResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/berths\x12I\n" +
+	"\x03Get\x12\x13.berthv1.GetRequest\x1a\x14.berthv1.GetResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/berths/{id}\x12P\n" +
+	"\x06Create\x12\x16.berthv1.CreateRequest\x1a\x17.berthv1.CreateResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/berths\x12U\n" +
+	"\x06Update\x12\x16.berthv1.UpdateRequest\x1a\x17.berthv1.UpdateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/berths/{id}\x12R\n" +
+	"\x06Delete\x12\x16.berthv1.DeleteRequest\x1a\x17.berthv1.DeleteResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/berths/{id}B7Z5github.com/deadsnxcks/dbcp/protos/proto/berth;berthv1b\x06proto3"
+
+var (
+	file_berth_berth_proto_rawDescOnce sync.Once
+	file_berth_berth_proto_rawDescData []byte
+)
+
+func file_berth_berth_proto_rawDescGZIP() []byte {
+	file_berth_berth_proto_rawDescOnce.Do(func() {
+		file_berth_berth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_berth_berth_proto_rawDesc), len(file_berth_berth_proto_rawDesc)))
+	})
+	return file_berth_berth_proto_rawDescData
+}
+
+var file_berth_berth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_berth_berth_proto_goTypes = []any{
+	(*Berth)(nil),          // 0: berthv1.Berth
+	(*ListRequest)(nil),    // 1: berthv1.ListRequest
+	(*ListResponse)(nil),   // 2: berthv1.ListResponse
+	(*GetRequest)(nil),     // 3: berthv1.GetRequest
+	(*GetResponse)(nil),    // 4: berthv1.GetResponse
+	(*CreateRequest)(nil),  // 5: berthv1.CreateRequest
+	(*CreateResponse)(nil), // 6: berthv1.CreateResponse
+	(*VesselTypes)(nil),    // 7: berthv1.VesselTypes
+	(*UpdateRequest)(nil),  // 8: berthv1.UpdateRequest
+	(*UpdateResponse)(nil), // 9: berthv1.UpdateResponse
+	(*DeleteRequest)(nil),  // 10: berthv1.DeleteRequest
+	(*DeleteResponse)(nil), // 11: berthv1.DeleteResponse
+}
+var file_berth_berth_proto_depIdxs = []int32{
+	0,  // 0: berthv1.ListResponse.berths:type_name -> berthv1.Berth
+	0,  // 1: berthv1.GetResponse.berth:type_name -> berthv1.Berth
+	7,  // 2: berthv1.UpdateRequest.allowed_vessel_types:type_name -> berthv1.VesselTypes
+	1,  // 3: berthv1.BerthService.List:input_type -> berthv1.ListRequest
+	3,  // 4: berthv1.BerthService.Get:input_type -> berthv1.GetRequest
+	5,  // 5: berthv1.BerthService.Create:input_type -> berthv1.CreateRequest
+	8,  // 6: berthv1.BerthService.Update:input_type -> berthv1.UpdateRequest
+	10, // 7: berthv1.BerthService.Delete:input_type -> berthv1.DeleteRequest
+	2,  // 8: berthv1.BerthService.List:output_type -> berthv1.ListResponse
+	4,  // 9: berthv1.BerthService.Get:output_type -> berthv1.GetResponse
+	6,  // 10: berthv1.BerthService.Create:output_type -> berthv1.CreateResponse
+	9,  // 11: berthv1.BerthService.Update:output_type -> berthv1.UpdateResponse
+	11, // 12: berthv1.BerthService.Delete:output_type -> berthv1.DeleteResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_berth_berth_proto_init() }
+func file_berth_berth_proto_init() {
+	if File_berth_berth_proto != nil {
+		return
+	}
+	file_berth_berth_proto_msgTypes[2].OneofWrappers = []any{}
+	file_berth_berth_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_berth_berth_proto_rawDesc), len(file_berth_berth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_berth_berth_proto_goTypes,
+		DependencyIndexes: file_berth_berth_proto_depIdxs,
+		MessageInfos:      file_berth_berth_proto_msgTypes,
+	}.Build()
+	File_berth_berth_proto = out.File
+	file_berth_berth_proto_goTypes = nil
+	file_berth_berth_proto_depIdxs = nil
+}
@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: berthschedule/berthschedule.proto
+
+package berthschedulev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Allocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BerthId       int64                  `protobuf:"varint,2,opt,name=berth_id,json=berthId,proto3" json:"berth_id,omitempty"`
+	VesselId      int64                  `protobuf:"varint,3,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	PortCallId    *int64                 `protobuf:"varint,4,opt,name=port_call_id,json=portCallId,proto3,oneof" json:"port_call_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allocation) Reset() {
+	*x = Allocation{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allocation) ProtoMessage() {}
+
+func (x *Allocation) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Allocation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Allocation) GetBerthId() int64 {
+	if x != nil {
+		return x.BerthId
+	}
+	return 0
+}
+
+func (x *Allocation) GetVesselId() int64 {
+	if x != nil {
+		return x.VesselId
+	}
+	return 0
+}
+
+func (x *Allocation) GetPortCallId() int64 {
+	if x != nil && x.PortCallId != nil {
+		return *x.PortCallId
+	}
+	return 0
+}
+
+func (x *Allocation) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Allocation) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type ListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	BerthId        *int64                 `protobuf:"varint,4,opt,name=berth_id,json=berthId,proto3,oneof" json:"berth_id,omitempty"`
+	VesselId       *int64                 `protobuf:"varint,5,opt,name=vessel_id,json=vesselId,proto3,oneof" json:"vessel_id,omitempty"`
+	From           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListRequest) GetBerthId() int64 {
+	if x != nil && x.BerthId != nil {
+		return *x.BerthId
+	}
+	return 0
+}
+
+func (x *ListRequest) GetVesselId() int64 {
+	if x != nil && x.VesselId != nil {
+		return *x.VesselId
+	}
+	return 0
+}
+
+func (x *ListRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocations   []*Allocation          `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListResponse) GetAllocations() []*Allocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocation    *Allocation            `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetResponse) GetAllocation() *Allocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+// When port_call_id is set, vessel_id and the time window default
+// to the vessel and ETA/ETD of that port call.
+type AllocateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BerthId       int64                  `protobuf:"varint,1,opt,name=berth_id,json=berthId,proto3" json:"berth_id,omitempty"`
+	VesselId      int64                  `protobuf:"varint,2,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	PortCallId    *int64                 `protobuf:"varint,3,opt,name=port_call_id,json=portCallId,proto3,oneof" json:"port_call_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateRequest) Reset() {
+	*x = AllocateRequest{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateRequest) ProtoMessage() {}
+
+func (x *AllocateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateRequest.ProtoReflect.Descriptor instead.
+func (*AllocateRequest) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AllocateRequest) GetBerthId() int64 {
+	if x != nil {
+		return x.BerthId
+	}
+	return 0
+}
+
+func (x *AllocateRequest) GetVesselId() int64 {
+	if x != nil {
+		return x.VesselId
+	}
+	return 0
+}
+
+func (x *AllocateRequest) GetPortCallId() int64 {
+	if x != nil && x.PortCallId != nil {
+		return *x.PortCallId
+	}
+	return 0
+}
+
+func (x *AllocateRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AllocateRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type AllocateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateResponse) Reset() {
+	*x = AllocateResponse{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateResponse) ProtoMessage() {}
+
+func (x *AllocateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateResponse.ProtoReflect.Descriptor instead.
+func (*AllocateResponse) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AllocateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_berthschedule_berthschedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_berthschedule_berthschedule_proto_rawDescGZIP(), []int{8}
+}
+
+var File_berthschedule_berthschedule_proto protoreflect.FileDescriptor
+
+const file_berthschedule_berthschedule_proto_rawDesc = "" +
+	"\n" +
+	"!berthschedule/berthschedule.proto\x12\x0fberthschedulev1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x01\n" +
+	"\n" +
+	"Allocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bberth_id\x18\x02 \x01(\x03R\aberthId\x12\x1b\n" +
+	"\tvessel_id\x18\x03 \x01(\x03R\bvesselId\x12%\n" +
+	"\fport_call_id\x18\x04 \x01(\x03H\x00R\n" +
+	"portCallId\x88\x01\x01\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAtB\x0f\n" +
+	"\r_port_call_id\"\xc6\x02\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\x12\x1e\n" +
+	"\bberth_id\x18\x04 \x01(\x03H\x00R\aberthId\x88\x01\x01\x12 \n" +
+	"\tvessel_id\x18\x05 \x01(\x03H\x01R\bvesselId\x88\x01\x01\x123\n" +
+	"\x04from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x02to\x88\x01\x01B\v\n" +
+	"\t_berth_idB\f\n" +
+	"\n" +
+	"_vessel_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xab\x01\n" +
+	"\fListResponse\x12=\n" +
+	"\vallocations\x18\x01 \x03(\v2\x1b.berthschedulev1.AllocationR\vallocations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x1c\n" +
+	"\n" +
+	"GetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
+	"\vGetResponse\x12;\n" +
+	"\n" +
+	"allocation\x18\x01 \x01(\v2\x1b.berthschedulev1.AllocationR\n" +
+	"allocation\"\xef\x01\n" +
+	"\x0fAllocateRequest\x12\x19\n" +
+	"\bberth_id\x18\x01 \x01(\x03R\aberthId\x12\x1b\n" +
+	"\tvessel_id\x18\x02 \x01(\x03R\bvesselId\x12%\n" +
+	"\fport_call_id\x18\x03 \x01(\x03H\x00R\n" +
+	"portCallId\x88\x01\x01\x127\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAtB\x0f\n" +
+	"\r_port_call_id\"\"\n" +
+	"\x10AllocateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1f\n" +
+	"\rCancelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
+	"\x0eCancelResponse2\xcc\x03\n" +
+	"\x14BerthScheduleService\x12b\n" +
+	"\x04List\x12\x1c.berthschedulev1.ListRequest\x1a\x1d.berthschedulev1.ListResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/berth-allocations\x12d\n" +
+	"\x03Get\x12\x1b.berthschedulev1.GetRequest\x1a\x1c.berthschedulev1.GetResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/berth-allocations/{id}\x12q\n" +
+	"\bAllocate\x12 .berthschedulev1.AllocateRequest\x1a!.berthschedulev1.AllocateResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/berth-allocations\x12w\n" +
+	"\x06Cancel\x12\x1e.berthschedulev1.CancelRequest\x1a\x1f.berthschedulev1.CancelResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/berth-allocations/{id}:cancelBGZEgithub.com/deadsnxcks/dbcp/protos/proto/berthschedule;berthschedulev1b\x06proto3"
+
+var (
+	file_berthschedule_berthschedule_proto_rawDescOnce sync.Once
+	file_berthschedule_berthschedule_proto_rawDescData []byte
+)
+
+func file_berthschedule_berthschedule_proto_rawDescGZIP() []byte {
+	file_berthschedule_berthschedule_proto_rawDescOnce.Do(func() {
+		file_berthschedule_berthschedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_berthschedule_berthschedule_proto_rawDesc), len(file_berthschedule_berthschedule_proto_rawDesc)))
+	})
+	return file_berthschedule_berthschedule_proto_rawDescData
+}
+
+var file_berthschedule_berthschedule_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_berthschedule_berthschedule_proto_goTypes = []any{
+	(*Allocation)(nil),            // 0: berthschedulev1.Allocation
+	(*ListRequest)(nil),           // 1: berthschedulev1.ListRequest
+	(*ListResponse)(nil),          // 2: berthschedulev1.ListResponse
+	(*GetRequest)(nil),            // 3: berthschedulev1.GetRequest
+	(*GetResponse)(nil),           // 4: berthschedulev1.GetResponse
+	(*AllocateRequest)(nil),       // 5: berthschedulev1.AllocateRequest
+	(*AllocateResponse)(nil),      // 6: berthschedulev1.AllocateResponse
+	(*CancelRequest)(nil),         // 7: berthschedulev1.CancelRequest
+	(*CancelResponse)(nil),        // 8: berthschedulev1.CancelResponse
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_berthschedule_berthschedule_proto_depIdxs = []int32{
+	9,  // 0: berthschedulev1.Allocation.starts_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: berthschedulev1.Allocation.ends_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: berthschedulev1.ListRequest.from:type_name -> google.protobuf.Timestamp
+	9,  // 3: berthschedulev1.ListRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 4: berthschedulev1.ListResponse.allocations:type_name -> berthschedulev1.Allocation
+	0,  // 5: berthschedulev1.GetResponse.allocation:type_name -> berthschedulev1.Allocation
+	9,  // 6: berthschedulev1.AllocateRequest.starts_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: berthschedulev1.AllocateRequest.ends_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: berthschedulev1.BerthScheduleService.List:input_type -> berthschedulev1.ListRequest
+	3,  // 9: berthschedulev1.BerthScheduleService.Get:input_type -> berthschedulev1.GetRequest
+	5,  // 10: berthschedulev1.BerthScheduleService.Allocate:input_type -> berthschedulev1.AllocateRequest
+	7,  // 11: berthschedulev1.BerthScheduleService.Cancel:input_type -> berthschedulev1.CancelRequest
+	2,  // 12: berthschedulev1.BerthScheduleService.List:output_type -> berthschedulev1.ListResponse
+	4,  // 13: berthschedulev1.BerthScheduleService.Get:output_type -> berthschedulev1.GetResponse
+	6,  // 14: berthschedulev1.BerthScheduleService.Allocate:output_type -> berthschedulev1.AllocateResponse
+	8,  // 15: berthschedulev1.BerthScheduleService.Cancel:output_type -> berthschedulev1.CancelResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_berthschedule_berthschedule_proto_init() }
+func file_berthschedule_berthschedule_proto_init() {
+	if File_berthschedule_berthschedule_proto != nil {
+		return
+	}
+	file_berthschedule_berthschedule_proto_msgTypes[0].OneofWrappers = []any{}
+	file_berthschedule_berthschedule_proto_msgTypes[1].OneofWrappers = []any{}
+	file_berthschedule_berthschedule_proto_msgTypes[2].OneofWrappers = []any{}
+	file_berthschedule_berthschedule_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_berthschedule_berthschedule_proto_rawDesc), len(file_berthschedule_berthschedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_berthschedule_berthschedule_proto_goTypes,
+		DependencyIndexes: file_berthschedule_berthschedule_proto_depIdxs,
+		MessageInfos:      file_berthschedule_berthschedule_proto_msgTypes,
+	}.Build()
+	File_berthschedule_berthschedule_proto = out.File
+	file_berthschedule_berthschedule_proto_goTypes = nil
+	file_berthschedule_berthschedule_proto_depIdxs = nil
+}
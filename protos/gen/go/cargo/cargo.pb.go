@@ -7,8 +7,10 @@
 package cargov1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +23,125 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CargoStatus int32
+
+const (
+	CargoStatus_CARGO_STATUS_UNSPECIFIED CargoStatus = 0
+	CargoStatus_CARGO_STATUS_EXPECTED    CargoStatus = 1
+	CargoStatus_CARGO_STATUS_ON_VESSEL   CargoStatus = 2
+	CargoStatus_CARGO_STATUS_UNLOADED    CargoStatus = 3
+	CargoStatus_CARGO_STATUS_IN_STORAGE  CargoStatus = 4
+	CargoStatus_CARGO_STATUS_RELEASED    CargoStatus = 5
+	CargoStatus_CARGO_STATUS_LOADED      CargoStatus = 6
+	CargoStatus_CARGO_STATUS_DEPARTED    CargoStatus = 7
+)
+
+// Enum value maps for CargoStatus.
+var (
+	CargoStatus_name = map[int32]string{
+		0: "CARGO_STATUS_UNSPECIFIED",
+		1: "CARGO_STATUS_EXPECTED",
+		2: "CARGO_STATUS_ON_VESSEL",
+		3: "CARGO_STATUS_UNLOADED",
+		4: "CARGO_STATUS_IN_STORAGE",
+		5: "CARGO_STATUS_RELEASED",
+		6: "CARGO_STATUS_LOADED",
+		7: "CARGO_STATUS_DEPARTED",
+	}
+	CargoStatus_value = map[string]int32{
+		"CARGO_STATUS_UNSPECIFIED": 0,
+		"CARGO_STATUS_EXPECTED":    1,
+		"CARGO_STATUS_ON_VESSEL":   2,
+		"CARGO_STATUS_UNLOADED":    3,
+		"CARGO_STATUS_IN_STORAGE":  4,
+		"CARGO_STATUS_RELEASED":    5,
+		"CARGO_STATUS_LOADED":      6,
+		"CARGO_STATUS_DEPARTED":    7,
+	}
+)
+
+func (x CargoStatus) Enum() *CargoStatus {
+	p := new(CargoStatus)
+	*p = x
+	return p
+}
+
+func (x CargoStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CargoStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cargo_cargo_proto_enumTypes[0].Descriptor()
+}
+
+func (CargoStatus) Type() protoreflect.EnumType {
+	return &file_cargo_cargo_proto_enumTypes[0]
+}
+
+func (x CargoStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CargoStatus.Descriptor instead.
+func (CargoStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{0}
+}
+
+type CargoSortField int32
+
+const (
+	CargoSortField_CARGO_SORT_FIELD_UNSPECIFIED CargoSortField = 0
+	CargoSortField_CARGO_SORT_FIELD_ID          CargoSortField = 1
+	CargoSortField_CARGO_SORT_FIELD_TITLE       CargoSortField = 2
+	CargoSortField_CARGO_SORT_FIELD_WEIGHT      CargoSortField = 3
+	CargoSortField_CARGO_SORT_FIELD_VOLUME      CargoSortField = 4
+)
+
+// Enum value maps for CargoSortField.
+var (
+	CargoSortField_name = map[int32]string{
+		0: "CARGO_SORT_FIELD_UNSPECIFIED",
+		1: "CARGO_SORT_FIELD_ID",
+		2: "CARGO_SORT_FIELD_TITLE",
+		3: "CARGO_SORT_FIELD_WEIGHT",
+		4: "CARGO_SORT_FIELD_VOLUME",
+	}
+	CargoSortField_value = map[string]int32{
+		"CARGO_SORT_FIELD_UNSPECIFIED": 0,
+		"CARGO_SORT_FIELD_ID":          1,
+		"CARGO_SORT_FIELD_TITLE":       2,
+		"CARGO_SORT_FIELD_WEIGHT":      3,
+		"CARGO_SORT_FIELD_VOLUME":      4,
+	}
+)
+
+func (x CargoSortField) Enum() *CargoSortField {
+	p := new(CargoSortField)
+	*p = x
+	return p
+}
+
+func (x CargoSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CargoSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_cargo_cargo_proto_enumTypes[1].Descriptor()
+}
+
+func (CargoSortField) Type() protoreflect.EnumType {
+	return &file_cargo_cargo_proto_enumTypes[1]
+}
+
+func (x CargoSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CargoSortField.Descriptor instead.
+func (CargoSortField) EnumDescriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{1}
+}
+
 type Cargo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -29,6 +150,10 @@ type Cargo struct {
 	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
 	Volume        float64                `protobuf:"fixed64,5,opt,name=volume,proto3" json:"volume,omitempty"`
 	VesselId      int64                  `protobuf:"varint,6,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	PortCallId    *int64                 `protobuf:"varint,7,opt,name=port_call_id,json=portCallId,proto3,oneof" json:"port_call_id,omitempty"`
+	Status        CargoStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=cargov1.CargoStatus" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,15 +230,123 @@ func (x *Cargo) GetVesselId() int64 {
 	return 0
 }
 
+func (x *Cargo) GetPortCallId() int64 {
+	if x != nil && x.PortCallId != nil {
+		return *x.PortCallId
+	}
+	return 0
+}
+
+func (x *Cargo) GetStatus() CargoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CargoStatus_CARGO_STATUS_UNSPECIFIED
+}
+
+func (x *Cargo) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Cargo) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type StoragePlacement struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorageLocationId int64                  `protobuf:"varint,2,opt,name=storage_location_id,json=storageLocationId,proto3" json:"storage_location_id,omitempty"`
+	CargoId           int64                  `protobuf:"varint,3,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	PlacedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	ReleasedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=released_at,json=releasedAt,proto3,oneof" json:"released_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StoragePlacement) Reset() {
+	*x = StoragePlacement{}
+	mi := &file_cargo_cargo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePlacement) ProtoMessage() {}
+
+func (x *StoragePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePlacement.ProtoReflect.Descriptor instead.
+func (*StoragePlacement) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoragePlacement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StoragePlacement) GetStorageLocationId() int64 {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return 0
+}
+
+func (x *StoragePlacement) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *StoragePlacement) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+func (x *StoragePlacement) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount  bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
 	*x = ListRequest{}
-	mi := &file_cargo_cargo_proto_msgTypes[1]
+	mi := &file_cargo_cargo_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -125,7 +358,7 @@ func (x *ListRequest) String() string {
 func (*ListRequest) ProtoMessage() {}
 
 func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[1]
+	mi := &file_cargo_cargo_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,19 +371,49 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{1}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
 }
 
 type ListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cargos        []*Cargo               `protobuf:"bytes,1,rep,name=cargos,proto3" json:"cargos,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListResponse) Reset() {
 	*x = ListResponse{}
-	mi := &file_cargo_cargo_proto_msgTypes[2]
+	mi := &file_cargo_cargo_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +425,7 @@ func (x *ListResponse) String() string {
 func (*ListResponse) ProtoMessage() {}
 
 func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[2]
+	mi := &file_cargo_cargo_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +438,7 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{2}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListResponse) GetCargos() []*Cargo {
@@ -185,16 +448,31 @@ func (x *ListResponse) GetCargos() []*Cargo {
 	return nil
 }
 
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_cargo_cargo_proto_msgTypes[3]
+	mi := &file_cargo_cargo_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +484,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[3]
+	mi := &file_cargo_cargo_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +497,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{3}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetRequest) GetId() int64 {
@@ -229,6 +507,13 @@ func (x *GetRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type GetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cargo         *Cargo                 `protobuf:"bytes,1,opt,name=cargo,proto3" json:"cargo,omitempty"`
@@ -238,7 +523,7 @@ type GetResponse struct {
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_cargo_cargo_proto_msgTypes[4]
+	mi := &file_cargo_cargo_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -250,7 +535,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[4]
+	mi := &file_cargo_cargo_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +548,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{4}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetResponse) GetCargo() *Cargo {
@@ -280,13 +565,14 @@ type CreateRequest struct {
 	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
 	Volume        float64                `protobuf:"fixed64,4,opt,name=volume,proto3" json:"volume,omitempty"`
 	VesselId      int64                  `protobuf:"varint,5,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	PortCallId    *int64                 `protobuf:"varint,6,opt,name=port_call_id,json=portCallId,proto3,oneof" json:"port_call_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_cargo_cargo_proto_msgTypes[5]
+	mi := &file_cargo_cargo_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +584,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[5]
+	mi := &file_cargo_cargo_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +597,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{5}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateRequest) GetTitle() string {
@@ -349,6 +635,13 @@ func (x *CreateRequest) GetVesselId() int64 {
 	return 0
 }
 
+func (x *CreateRequest) GetPortCallId() int64 {
+	if x != nil && x.PortCallId != nil {
+		return *x.PortCallId
+	}
+	return 0
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -358,7 +651,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_cargo_cargo_proto_msgTypes[6]
+	mi := &file_cargo_cargo_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +663,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[6]
+	mi := &file_cargo_cargo_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +676,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{6}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateResponse) GetId() int64 {
@@ -394,20 +687,22 @@ func (x *CreateResponse) GetId() int64 {
 }
 
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	TypeId        *int64                 `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id,omitempty"`
-	Weight        *float64               `protobuf:"fixed64,4,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
-	Volume        *float64               `protobuf:"fixed64,5,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
-	VesselId      *int64                 `protobuf:"varint,6,opt,name=vessel_id,json=vesselId,proto3,oneof" json:"vessel_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	TypeId          *int64                 `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id,omitempty"`
+	Weight          *float64               `protobuf:"fixed64,4,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Volume          *float64               `protobuf:"fixed64,5,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	VesselId        *int64                 `protobuf:"varint,6,opt,name=vessel_id,json=vesselId,proto3,oneof" json:"vessel_id,omitempty"`
+	PortCallId      *int64                 `protobuf:"varint,7,opt,name=port_call_id,json=portCallId,proto3,oneof" json:"port_call_id,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_cargo_cargo_proto_msgTypes[7]
+	mi := &file_cargo_cargo_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -419,7 +714,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[7]
+	mi := &file_cargo_cargo_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +727,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{7}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateRequest) GetId() int64 {
@@ -477,6 +772,20 @@ func (x *UpdateRequest) GetVesselId() int64 {
 	return 0
 }
 
+func (x *UpdateRequest) GetPortCallId() int64 {
+	if x != nil && x.PortCallId != nil {
+		return *x.PortCallId
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -485,7 +794,7 @@ type UpdateResponse struct {
 
 func (x *UpdateResponse) Reset() {
 	*x = UpdateResponse{}
-	mi := &file_cargo_cargo_proto_msgTypes[8]
+	mi := &file_cargo_cargo_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +806,7 @@ func (x *UpdateResponse) String() string {
 func (*UpdateResponse) ProtoMessage() {}
 
 func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[8]
+	mi := &file_cargo_cargo_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +819,7 @@ func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{8}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{9}
 }
 
 type DeleteRequest struct {
@@ -522,7 +831,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_cargo_cargo_proto_msgTypes[9]
+	mi := &file_cargo_cargo_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +843,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[9]
+	mi := &file_cargo_cargo_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +856,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{9}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteRequest) GetId() int64 {
@@ -565,7 +874,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_cargo_cargo_proto_msgTypes[10]
+	mi := &file_cargo_cargo_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +886,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cargo_cargo_proto_msgTypes[10]
+	mi := &file_cargo_cargo_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,61 +899,712 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_cargo_cargo_proto_rawDescGZIP(), []int{10}
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{11}
 }
 
-var File_cargo_cargo_proto protoreflect.FileDescriptor
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_cargo_cargo_proto_rawDesc = "" +
-	"\n" +
-	"\x11cargo/cargo.proto\x12\acargov1\"\x93\x01\n" +
-	"\x05Cargo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
-	"\atype_id\x18\x03 \x01(\x03R\x06typeId\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x16\n" +
-	"\x06volume\x18\x05 \x01(\x01R\x06volume\x12\x1b\n" +
-	"\tvessel_id\x18\x06 \x01(\x03R\bvesselId\"\r\n" +
-	"\vListRequest\"6\n" +
-	"\fListResponse\x12&\n" +
-	"\x06cargos\x18\x01 \x03(\v2\x0e.cargov1.CargoR\x06cargos\"\x1c\n" +
-	"\n" +
-	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
-	"\vGetResponse\x12$\n" +
-	"\x05cargo\x18\x01 \x01(\v2\x0e.cargov1.CargoR\x05cargo\"\x8b\x01\n" +
-	"\rCreateRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x17\n" +
-	"\atype_id\x18\x02 \x01(\x03R\x06typeId\x12\x16\n" +
-	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x16\n" +
-	"\x06volume\x18\x04 \x01(\x01R\x06volume\x12\x1b\n" +
-	"\tvessel_id\x18\x05 \x01(\x03R\bvesselId\" \n" +
-	"\x0eCreateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xee\x01\n" +
-	"\rUpdateRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1c\n" +
-	"\atype_id\x18\x03 \x01(\x03H\x01R\x06typeId\x88\x01\x01\x12\x1b\n" +
-	"\x06weight\x18\x04 \x01(\x01H\x02R\x06weight\x88\x01\x01\x12\x1b\n" +
-	"\x06volume\x18\x05 \x01(\x01H\x03R\x06volume\x88\x01\x01\x12 \n" +
-	"\tvessel_id\x18\x06 \x01(\x03H\x04R\bvesselId\x88\x01\x01B\b\n" +
-	"\x06_titleB\n" +
-	"\n" +
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_cargo_cargo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	mi := &file_cargo_cargo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{13}
+}
+
+type SearchRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TypeId          *int64                 `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id,omitempty"`
+	VesselId        *int64                 `protobuf:"varint,2,opt,name=vessel_id,json=vesselId,proto3,oneof" json:"vessel_id,omitempty"`
+	MinWeight       *float64               `protobuf:"fixed64,3,opt,name=min_weight,json=minWeight,proto3,oneof" json:"min_weight,omitempty"`
+	MaxWeight       *float64               `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3,oneof" json:"max_weight,omitempty"`
+	MinVolume       *float64               `protobuf:"fixed64,5,opt,name=min_volume,json=minVolume,proto3,oneof" json:"min_volume,omitempty"`
+	MaxVolume       *float64               `protobuf:"fixed64,6,opt,name=max_volume,json=maxVolume,proto3,oneof" json:"max_volume,omitempty"`
+	TitleContains   *string                `protobuf:"bytes,7,opt,name=title_contains,json=titleContains,proto3,oneof" json:"title_contains,omitempty"`
+	Placed          *bool                  `protobuf:"varint,8,opt,name=placed,proto3,oneof" json:"placed,omitempty"`
+	SortBy          CargoSortField         `protobuf:"varint,9,opt,name=sort_by,json=sortBy,proto3,enum=cargov1.CargoSortField" json:"sort_by,omitempty"`
+	Descending      bool                   `protobuf:"varint,10,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize        int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PortCallId      *int64                 `protobuf:"varint,13,opt,name=port_call_id,json=portCallId,proto3,oneof" json:"port_call_id,omitempty"`
+	Status          *CargoStatus           `protobuf:"varint,14,opt,name=status,proto3,enum=cargov1.CargoStatus,oneof" json:"status,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,15,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	WithTotalCount  bool                   `protobuf:"varint,16,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_cargo_cargo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchRequest) GetTypeId() int64 {
+	if x != nil && x.TypeId != nil {
+		return *x.TypeId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetVesselId() int64 {
+	if x != nil && x.VesselId != nil {
+		return *x.VesselId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMinWeight() float64 {
+	if x != nil && x.MinWeight != nil {
+		return *x.MinWeight
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMaxWeight() float64 {
+	if x != nil && x.MaxWeight != nil {
+		return *x.MaxWeight
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMinVolume() float64 {
+	if x != nil && x.MinVolume != nil {
+		return *x.MinVolume
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMaxVolume() float64 {
+	if x != nil && x.MaxVolume != nil {
+		return *x.MaxVolume
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetTitleContains() string {
+	if x != nil && x.TitleContains != nil {
+		return *x.TitleContains
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPlaced() bool {
+	if x != nil && x.Placed != nil {
+		return *x.Placed
+	}
+	return false
+}
+
+func (x *SearchRequest) GetSortBy() CargoSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CargoSortField_CARGO_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *SearchRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPortCallId() int64 {
+	if x != nil && x.PortCallId != nil {
+		return *x.PortCallId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetStatus() CargoStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return CargoStatus_CARGO_STATUS_UNSPECIFIED
+}
+
+func (x *SearchRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *SearchRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cargos        []*Cargo               `protobuf:"bytes,1,rep,name=cargos,proto3" json:"cargos,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_cargo_cargo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchResponse) GetCargos() []*Cargo {
+	if x != nil {
+		return x.Cargos
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type TransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        CargoStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=cargov1.CargoStatus" json:"status,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionRequest) Reset() {
+	*x = TransitionRequest{}
+	mi := &file_cargo_cargo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionRequest) ProtoMessage() {}
+
+func (x *TransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionRequest.ProtoReflect.Descriptor instead.
+func (*TransitionRequest) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransitionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransitionRequest) GetStatus() CargoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CargoStatus_CARGO_STATUS_UNSPECIFIED
+}
+
+func (x *TransitionRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type TransitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionResponse) Reset() {
+	*x = TransitionResponse{}
+	mi := &file_cargo_cargo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionResponse) ProtoMessage() {}
+
+func (x *TransitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionResponse.ProtoReflect.Descriptor instead.
+func (*TransitionResponse) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{17}
+}
+
+type PlacementHistoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,4,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlacementHistoryRequest) Reset() {
+	*x = PlacementHistoryRequest{}
+	mi := &file_cargo_cargo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementHistoryRequest) ProtoMessage() {}
+
+func (x *PlacementHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PlacementHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlacementHistoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlacementHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PlacementHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PlacementHistoryRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+type PlacementHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placements    []*StoragePlacement    `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementHistoryResponse) Reset() {
+	*x = PlacementHistoryResponse{}
+	mi := &file_cargo_cargo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementHistoryResponse) ProtoMessage() {}
+
+func (x *PlacementHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cargo_cargo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PlacementHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_cargo_cargo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlacementHistoryResponse) GetPlacements() []*StoragePlacement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *PlacementHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PlacementHistoryResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+var File_cargo_cargo_proto protoreflect.FileDescriptor
+
+const file_cargo_cargo_proto_rawDesc = "" +
+	"\n" +
+	"\x11cargo/cargo.proto\x12\acargov1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x02\n" +
+	"\x05Cargo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\x03R\x06typeId\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06volume\x18\x05 \x01(\x01R\x06volume\x12\x1b\n" +
+	"\tvessel_id\x18\x06 \x01(\x03R\bvesselId\x12%\n" +
+	"\fport_call_id\x18\a \x01(\x03H\x00R\n" +
+	"portCallId\x88\x01\x01\x12,\n" +
+	"\x06status\x18\b \x01(\x0e2\x14.cargov1.CargoStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x12@\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"archivedAt\x88\x01\x01B\x0f\n" +
+	"\r_port_call_idB\x0e\n" +
+	"\f_archived_at\"\xf8\x01\n" +
+	"\x10StoragePlacement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12.\n" +
+	"\x13storage_location_id\x18\x02 \x01(\x03R\x11storageLocationId\x12\x19\n" +
+	"\bcargo_id\x18\x03 \x01(\x03R\acargoId\x127\n" +
+	"\tplaced_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\x12@\n" +
+	"\vreleased_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"releasedAt\x88\x01\x01B\x0e\n" +
+	"\f_released_at\"\x9e\x01\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"\x94\x01\n" +
+	"\fListResponse\x12&\n" +
+	"\x06cargos\x18\x01 \x03(\v2\x0e.cargov1.CargoR\x06cargos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"G\n" +
+	"\n" +
+	"GetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"3\n" +
+	"\vGetResponse\x12$\n" +
+	"\x05cargo\x18\x01 \x01(\v2\x0e.cargov1.CargoR\x05cargo\"\xc3\x01\n" +
+	"\rCreateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x17\n" +
+	"\atype_id\x18\x02 \x01(\x03R\x06typeId\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06volume\x18\x04 \x01(\x01R\x06volume\x12\x1b\n" +
+	"\tvessel_id\x18\x05 \x01(\x03R\bvesselId\x12%\n" +
+	"\fport_call_id\x18\x06 \x01(\x03H\x00R\n" +
+	"portCallId\x88\x01\x01B\x0f\n" +
+	"\r_port_call_id\" \n" +
+	"\x0eCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xeb\x02\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1c\n" +
+	"\atype_id\x18\x03 \x01(\x03H\x01R\x06typeId\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x04 \x01(\x01H\x02R\x06weight\x88\x01\x01\x12\x1b\n" +
+	"\x06volume\x18\x05 \x01(\x01H\x03R\x06volume\x88\x01\x01\x12 \n" +
+	"\tvessel_id\x18\x06 \x01(\x03H\x04R\bvesselId\x88\x01\x01\x12%\n" +
+	"\fport_call_id\x18\a \x01(\x03H\x05R\n" +
+	"portCallId\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\b \x01(\x03H\x06R\x0fexpectedVersion\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
 	"\b_type_idB\t\n" +
 	"\a_weightB\t\n" +
 	"\a_volumeB\f\n" +
 	"\n" +
-	"_vessel_id\"\x10\n" +
+	"_vessel_idB\x0f\n" +
+	"\r_port_call_idB\x13\n" +
+	"\x11_expected_version\"\x10\n" +
 	"\x0eUpdateResponse\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
-	"\x0eDeleteResponse2\xa6\x02\n" +
-	"\fCargoService\x123\n" +
-	"\x04List\x12\x14.cargov1.ListRequest\x1a\x15.cargov1.ListResponse\x129\n" +
-	"\x06Create\x12\x16.cargov1.CreateRequest\x1a\x17.cargov1.CreateResponse\x129\n" +
-	"\x06Delete\x12\x16.cargov1.DeleteRequest\x1a\x17.cargov1.DeleteResponse\x120\n" +
-	"\x03Get\x12\x13.cargov1.GetRequest\x1a\x14.cargov1.GetResponse\x129\n" +
-	"\x06Update\x12\x16.cargov1.UpdateRequest\x1a\x17.cargov1.UpdateResponseB7Z5github.com/deadsnxcks/dbcp/protos/proto/cargo;cargov1b\x06proto3"
+	"\x0eDeleteResponse\" \n" +
+	"\x0eRestoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x11\n" +
+	"\x0fRestoreResponse\"\xf5\x05\n" +
+	"\rSearchRequest\x12\x1c\n" +
+	"\atype_id\x18\x01 \x01(\x03H\x00R\x06typeId\x88\x01\x01\x12 \n" +
+	"\tvessel_id\x18\x02 \x01(\x03H\x01R\bvesselId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_weight\x18\x03 \x01(\x01H\x02R\tminWeight\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_weight\x18\x04 \x01(\x01H\x03R\tmaxWeight\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_volume\x18\x05 \x01(\x01H\x04R\tminVolume\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_volume\x18\x06 \x01(\x01H\x05R\tmaxVolume\x88\x01\x01\x12*\n" +
+	"\x0etitle_contains\x18\a \x01(\tH\x06R\rtitleContains\x88\x01\x01\x12\x1b\n" +
+	"\x06placed\x18\b \x01(\bH\aR\x06placed\x88\x01\x01\x120\n" +
+	"\asort_by\x18\t \x01(\x0e2\x17.cargov1.CargoSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\n" +
+	" \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\v \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\f \x01(\tR\tpageToken\x12%\n" +
+	"\fport_call_id\x18\r \x01(\x03H\bR\n" +
+	"portCallId\x88\x01\x01\x121\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x14.cargov1.CargoStatusH\tR\x06status\x88\x01\x01\x12)\n" +
+	"\x10include_archived\x18\x0f \x01(\bR\x0fincludeArchived\x12(\n" +
+	"\x10with_total_count\x18\x10 \x01(\bR\x0ewithTotalCountB\n" +
+	"\n" +
+	"\b_type_idB\f\n" +
+	"\n" +
+	"_vessel_idB\r\n" +
+	"\v_min_weightB\r\n" +
+	"\v_max_weightB\r\n" +
+	"\v_min_volumeB\r\n" +
+	"\v_max_volumeB\x11\n" +
+	"\x0f_title_containsB\t\n" +
+	"\a_placedB\x0f\n" +
+	"\r_port_call_idB\t\n" +
+	"\a_status\"\x96\x01\n" +
+	"\x0eSearchResponse\x12&\n" +
+	"\x06cargos\x18\x01 \x03(\v2\x0e.cargov1.CargoR\x06cargos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"}\n" +
+	"\x11TransitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x14.cargov1.CargoStatusR\x06status\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\x14\n" +
+	"\x12TransitionResponse\"\x8f\x01\n" +
+	"\x17PlacementHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x04 \x01(\bR\x0ewithTotalCount\"\xb3\x01\n" +
+	"\x18PlacementHistoryResponse\x129\n" +
+	"\n" +
+	"placements\x18\x01 \x03(\v2\x19.cargov1.StoragePlacementR\n" +
+	"placements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count*\xe9\x01\n" +
+	"\vCargoStatus\x12\x1c\n" +
+	"\x18CARGO_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CARGO_STATUS_EXPECTED\x10\x01\x12\x1a\n" +
+	"\x16CARGO_STATUS_ON_VESSEL\x10\x02\x12\x19\n" +
+	"\x15CARGO_STATUS_UNLOADED\x10\x03\x12\x1b\n" +
+	"\x17CARGO_STATUS_IN_STORAGE\x10\x04\x12\x19\n" +
+	"\x15CARGO_STATUS_RELEASED\x10\x05\x12\x17\n" +
+	"\x13CARGO_STATUS_LOADED\x10\x06\x12\x19\n" +
+	"\x15CARGO_STATUS_DEPARTED\x10\a*\xa1\x01\n" +
+	"\x0eCargoSortField\x12 \n" +
+	"\x1cCARGO_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CARGO_SORT_FIELD_ID\x10\x01\x12\x1a\n" +
+	"\x16CARGO_SORT_FIELD_TITLE\x10\x02\x12\x1b\n" +
+	"\x17CARGO_SORT_FIELD_WEIGHT\x10\x03\x12\x1b\n" +
+	"\x17CARGO_SORT_FIELD_VOLUME\x10\x042\xc2\x06\n" +
+	"\fCargoService\x12G\n" +
+	"\x04List\x12\x14.cargov1.ListRequest\x1a\x15.cargov1.ListResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/cargos\x12P\n" +
+	"\x06Create\x12\x16.cargov1.CreateRequest\x1a\x17.cargov1.CreateResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/cargos\x12R\n" +
+	"\x06Delete\x12\x16.cargov1.DeleteRequest\x1a\x17.cargov1.DeleteResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/cargos/{id}\x12I\n" +
+	"\x03Get\x12\x13.cargov1.GetRequest\x1a\x14.cargov1.GetResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/cargos/{id}\x12U\n" +
+	"\x06Update\x12\x16.cargov1.UpdateRequest\x1a\x17.cargov1.UpdateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/cargos/{id}\x12T\n" +
+	"\x06Search\x12\x16.cargov1.SearchRequest\x1a\x17.cargov1.SearchResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/cargos:search\x12l\n" +
+	"\n" +
+	"Transition\x12\x1a.cargov1.TransitionRequest\x1a\x1b.cargov1.TransitionResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cargos/{id}:transition\x12{\n" +
+	"\x10PlacementHistory\x12 .cargov1.PlacementHistoryRequest\x1a!.cargov1.PlacementHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/cargos/{id}/placements\x12`\n" +
+	"\aRestore\x12\x17.cargov1.RestoreRequest\x1a\x18.cargov1.RestoreResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/cargos/{id}:restoreB7Z5github.com/deadsnxcks/dbcp/protos/proto/cargo;cargov1b\x06proto3"
 
 var (
 	file_cargo_cargo_proto_rawDescOnce sync.Once
@@ -658,38 +1618,69 @@ func file_cargo_cargo_proto_rawDescGZIP() []byte {
 	return file_cargo_cargo_proto_rawDescData
 }
 
-var file_cargo_cargo_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cargo_cargo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cargo_cargo_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_cargo_cargo_proto_goTypes = []any{
-	(*Cargo)(nil),          // 0: cargov1.Cargo
-	(*ListRequest)(nil),    // 1: cargov1.ListRequest
-	(*ListResponse)(nil),   // 2: cargov1.ListResponse
-	(*GetRequest)(nil),     // 3: cargov1.GetRequest
-	(*GetResponse)(nil),    // 4: cargov1.GetResponse
-	(*CreateRequest)(nil),  // 5: cargov1.CreateRequest
-	(*CreateResponse)(nil), // 6: cargov1.CreateResponse
-	(*UpdateRequest)(nil),  // 7: cargov1.UpdateRequest
-	(*UpdateResponse)(nil), // 8: cargov1.UpdateResponse
-	(*DeleteRequest)(nil),  // 9: cargov1.DeleteRequest
-	(*DeleteResponse)(nil), // 10: cargov1.DeleteResponse
+	(CargoStatus)(0),                 // 0: cargov1.CargoStatus
+	(CargoSortField)(0),              // 1: cargov1.CargoSortField
+	(*Cargo)(nil),                    // 2: cargov1.Cargo
+	(*StoragePlacement)(nil),         // 3: cargov1.StoragePlacement
+	(*ListRequest)(nil),              // 4: cargov1.ListRequest
+	(*ListResponse)(nil),             // 5: cargov1.ListResponse
+	(*GetRequest)(nil),               // 6: cargov1.GetRequest
+	(*GetResponse)(nil),              // 7: cargov1.GetResponse
+	(*CreateRequest)(nil),            // 8: cargov1.CreateRequest
+	(*CreateResponse)(nil),           // 9: cargov1.CreateResponse
+	(*UpdateRequest)(nil),            // 10: cargov1.UpdateRequest
+	(*UpdateResponse)(nil),           // 11: cargov1.UpdateResponse
+	(*DeleteRequest)(nil),            // 12: cargov1.DeleteRequest
+	(*DeleteResponse)(nil),           // 13: cargov1.DeleteResponse
+	(*RestoreRequest)(nil),           // 14: cargov1.RestoreRequest
+	(*RestoreResponse)(nil),          // 15: cargov1.RestoreResponse
+	(*SearchRequest)(nil),            // 16: cargov1.SearchRequest
+	(*SearchResponse)(nil),           // 17: cargov1.SearchResponse
+	(*TransitionRequest)(nil),        // 18: cargov1.TransitionRequest
+	(*TransitionResponse)(nil),       // 19: cargov1.TransitionResponse
+	(*PlacementHistoryRequest)(nil),  // 20: cargov1.PlacementHistoryRequest
+	(*PlacementHistoryResponse)(nil), // 21: cargov1.PlacementHistoryResponse
+	(*timestamppb.Timestamp)(nil),    // 22: google.protobuf.Timestamp
 }
 var file_cargo_cargo_proto_depIdxs = []int32{
-	0,  // 0: cargov1.ListResponse.cargos:type_name -> cargov1.Cargo
-	0,  // 1: cargov1.GetResponse.cargo:type_name -> cargov1.Cargo
-	1,  // 2: cargov1.CargoService.List:input_type -> cargov1.ListRequest
-	5,  // 3: cargov1.CargoService.Create:input_type -> cargov1.CreateRequest
-	9,  // 4: cargov1.CargoService.Delete:input_type -> cargov1.DeleteRequest
-	3,  // 5: cargov1.CargoService.Get:input_type -> cargov1.GetRequest
-	7,  // 6: cargov1.CargoService.Update:input_type -> cargov1.UpdateRequest
-	2,  // 7: cargov1.CargoService.List:output_type -> cargov1.ListResponse
-	6,  // 8: cargov1.CargoService.Create:output_type -> cargov1.CreateResponse
-	10, // 9: cargov1.CargoService.Delete:output_type -> cargov1.DeleteResponse
-	4,  // 10: cargov1.CargoService.Get:output_type -> cargov1.GetResponse
-	8,  // 11: cargov1.CargoService.Update:output_type -> cargov1.UpdateResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	0,  // 0: cargov1.Cargo.status:type_name -> cargov1.CargoStatus
+	22, // 1: cargov1.Cargo.archived_at:type_name -> google.protobuf.Timestamp
+	22, // 2: cargov1.StoragePlacement.placed_at:type_name -> google.protobuf.Timestamp
+	22, // 3: cargov1.StoragePlacement.released_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: cargov1.ListResponse.cargos:type_name -> cargov1.Cargo
+	2,  // 5: cargov1.GetResponse.cargo:type_name -> cargov1.Cargo
+	1,  // 6: cargov1.SearchRequest.sort_by:type_name -> cargov1.CargoSortField
+	0,  // 7: cargov1.SearchRequest.status:type_name -> cargov1.CargoStatus
+	2,  // 8: cargov1.SearchResponse.cargos:type_name -> cargov1.Cargo
+	0,  // 9: cargov1.TransitionRequest.status:type_name -> cargov1.CargoStatus
+	22, // 10: cargov1.TransitionRequest.at:type_name -> google.protobuf.Timestamp
+	3,  // 11: cargov1.PlacementHistoryResponse.placements:type_name -> cargov1.StoragePlacement
+	4,  // 12: cargov1.CargoService.List:input_type -> cargov1.ListRequest
+	8,  // 13: cargov1.CargoService.Create:input_type -> cargov1.CreateRequest
+	12, // 14: cargov1.CargoService.Delete:input_type -> cargov1.DeleteRequest
+	6,  // 15: cargov1.CargoService.Get:input_type -> cargov1.GetRequest
+	10, // 16: cargov1.CargoService.Update:input_type -> cargov1.UpdateRequest
+	16, // 17: cargov1.CargoService.Search:input_type -> cargov1.SearchRequest
+	18, // 18: cargov1.CargoService.Transition:input_type -> cargov1.TransitionRequest
+	20, // 19: cargov1.CargoService.PlacementHistory:input_type -> cargov1.PlacementHistoryRequest
+	14, // 20: cargov1.CargoService.Restore:input_type -> cargov1.RestoreRequest
+	5,  // 21: cargov1.CargoService.List:output_type -> cargov1.ListResponse
+	9,  // 22: cargov1.CargoService.Create:output_type -> cargov1.CreateResponse
+	13, // 23: cargov1.CargoService.Delete:output_type -> cargov1.DeleteResponse
+	7,  // 24: cargov1.CargoService.Get:output_type -> cargov1.GetResponse
+	11, // 25: cargov1.CargoService.Update:output_type -> cargov1.UpdateResponse
+	17, // 26: cargov1.CargoService.Search:output_type -> cargov1.SearchResponse
+	19, // 27: cargov1.CargoService.Transition:output_type -> cargov1.TransitionResponse
+	21, // 28: cargov1.CargoService.PlacementHistory:output_type -> cargov1.PlacementHistoryResponse
+	15, // 29: cargov1.CargoService.Restore:output_type -> cargov1.RestoreResponse
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cargo_cargo_proto_init() }
@@ -697,19 +1688,27 @@ func file_cargo_cargo_proto_init() {
 	if File_cargo_cargo_proto != nil {
 		return
 	}
-	file_cargo_cargo_proto_msgTypes[7].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[0].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[1].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[3].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[6].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[8].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[14].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[15].OneofWrappers = []any{}
+	file_cargo_cargo_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cargo_cargo_proto_rawDesc), len(file_cargo_cargo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cargo_cargo_proto_goTypes,
 		DependencyIndexes: file_cargo_cargo_proto_depIdxs,
+		EnumInfos:         file_cargo_cargo_proto_enumTypes,
 		MessageInfos:      file_cargo_cargo_proto_msgTypes,
 	}.Build()
 	File_cargo_cargo_proto = out.File
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CargoService_List_FullMethodName   = "/cargov1.CargoService/List"
-	CargoService_Create_FullMethodName = "/cargov1.CargoService/Create"
-	CargoService_Delete_FullMethodName = "/cargov1.CargoService/Delete"
-	CargoService_Get_FullMethodName    = "/cargov1.CargoService/Get"
-	CargoService_Update_FullMethodName = "/cargov1.CargoService/Update"
+	CargoService_List_FullMethodName             = "/cargov1.CargoService/List"
+	CargoService_Create_FullMethodName           = "/cargov1.CargoService/Create"
+	CargoService_Delete_FullMethodName           = "/cargov1.CargoService/Delete"
+	CargoService_Get_FullMethodName              = "/cargov1.CargoService/Get"
+	CargoService_Update_FullMethodName           = "/cargov1.CargoService/Update"
+	CargoService_Search_FullMethodName           = "/cargov1.CargoService/Search"
+	CargoService_Transition_FullMethodName       = "/cargov1.CargoService/Transition"
+	CargoService_PlacementHistory_FullMethodName = "/cargov1.CargoService/PlacementHistory"
+	CargoService_Restore_FullMethodName          = "/cargov1.CargoService/Restore"
 )
 
 // CargoServiceClient is the client API for CargoService service.
@@ -35,6 +39,10 @@ type CargoServiceClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Transition(ctx context.Context, in *TransitionRequest, opts ...grpc.CallOption) (*TransitionResponse, error)
+	PlacementHistory(ctx context.Context, in *PlacementHistoryRequest, opts ...grpc.CallOption) (*PlacementHistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
 }
 
 type cargoServiceClient struct {
@@ -95,6 +103,46 @@ func (c *cargoServiceClient) Update(ctx context.Context, in *UpdateRequest, opts
 	return out, nil
 }
 
+func (c *cargoServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, CargoService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cargoServiceClient) Transition(ctx context.Context, in *TransitionRequest, opts ...grpc.CallOption) (*TransitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionResponse)
+	err := c.cc.Invoke(ctx, CargoService_Transition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cargoServiceClient) PlacementHistory(ctx context.Context, in *PlacementHistoryRequest, opts ...grpc.CallOption) (*PlacementHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacementHistoryResponse)
+	err := c.cc.Invoke(ctx, CargoService_PlacementHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cargoServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, CargoService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CargoServiceServer is the server API for CargoService service.
 // All implementations must embed UnimplementedCargoServiceServer
 // for forward compatibility.
@@ -104,6 +152,10 @@ type CargoServiceServer interface {
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Transition(context.Context, *TransitionRequest) (*TransitionResponse, error)
+	PlacementHistory(context.Context, *PlacementHistoryRequest) (*PlacementHistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
 	mustEmbedUnimplementedCargoServiceServer()
 }
 
@@ -129,6 +181,18 @@ func (UnimplementedCargoServiceServer) Get(context.Context, *GetRequest) (*GetRe
 func (UnimplementedCargoServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedCargoServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedCargoServiceServer) Transition(context.Context, *TransitionRequest) (*TransitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Transition not implemented")
+}
+func (UnimplementedCargoServiceServer) PlacementHistory(context.Context, *PlacementHistoryRequest) (*PlacementHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlacementHistory not implemented")
+}
+func (UnimplementedCargoServiceServer) Restore(context.Context, *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedCargoServiceServer) mustEmbedUnimplementedCargoServiceServer() {}
 func (UnimplementedCargoServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +304,78 @@ func _CargoService_Update_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CargoService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CargoServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CargoService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CargoServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CargoService_Transition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CargoServiceServer).Transition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CargoService_Transition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CargoServiceServer).Transition(ctx, req.(*TransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CargoService_PlacementHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlacementHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CargoServiceServer).PlacementHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CargoService_PlacementHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CargoServiceServer).PlacementHistory(ctx, req.(*PlacementHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CargoService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CargoServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CargoService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CargoServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CargoService_ServiceDesc is the grpc.ServiceDesc for CargoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +403,22 @@ var CargoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _CargoService_Update_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _CargoService_Search_Handler,
+		},
+		{
+			MethodName: "Transition",
+			Handler:    _CargoService_Transition_Handler,
+		},
+		{
+			MethodName: "PlacementHistory",
+			Handler:    _CargoService_PlacementHistory_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _CargoService_Restore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cargo/cargo.proto",
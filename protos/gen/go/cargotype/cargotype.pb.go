@@ -7,8 +7,10 @@
 package cargotypev1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,6 +28,8 @@ type CargoType struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	ProcessCost   float64                `protobuf:"fixed64,3,opt,name=process_cost,json=processCost,proto3" json:"process_cost,omitempty"`
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,10 +85,28 @@ func (x *CargoType) GetProcessCost() float64 {
 	return 0
 }
 
+func (x *CargoType) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CargoType) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount  bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
@@ -117,9 +139,39 @@ func (*ListRequest) Descriptor() ([]byte, []int) {
 	return file_cargotype_cargotype_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type ListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CargoTypes    []*CargoType           `protobuf:"bytes,1,rep,name=cargo_types,json=cargoTypes,proto3" json:"cargo_types,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -161,11 +213,26 @@ func (x *ListResponse) GetCargoTypes() []*CargoType {
 	return nil
 }
 
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetRequest) Reset() {
@@ -205,6 +272,13 @@ func (x *GetRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type GetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CargoType     *CargoType             `protobuf:"bytes,1,opt,name=cargo_type,json=cargoType,proto3" json:"cargo_type,omitempty"`
@@ -346,12 +420,13 @@ func (x *CreateResponse) GetId() int64 {
 }
 
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	ProcessCost   *float64               `protobuf:"fixed64,3,opt,name=process_cost,json=processCost,proto3,oneof" json:"process_cost,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	ProcessCost     *float64               `protobuf:"fixed64,3,opt,name=process_cost,json=processCost,proto3,oneof" json:"process_cost,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
@@ -405,6 +480,13 @@ func (x *UpdateRequest) GetProcessCost() float64 {
 	return 0
 }
 
+func (x *UpdateRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -521,22 +603,116 @@ func (*DeleteResponse) Descriptor() ([]byte, []int) {
 	return file_cargotype_cargotype_proto_rawDescGZIP(), []int{10}
 }
 
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_cargotype_cargotype_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cargotype_cargotype_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_cargotype_cargotype_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	mi := &file_cargotype_cargotype_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cargotype_cargotype_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_cargotype_cargotype_proto_rawDescGZIP(), []int{12}
+}
+
 var File_cargotype_cargotype_proto protoreflect.FileDescriptor
 
 const file_cargotype_cargotype_proto_rawDesc = "" +
 	"\n" +
-	"\x19cargotype/cargotype.proto\x12\vcargotypev1\"T\n" +
+	"\x19cargotype/cargotype.proto\x12\vcargotypev1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x01\n" +
 	"\tCargoType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
-	"\fprocess_cost\x18\x03 \x01(\x01R\vprocessCost\"\r\n" +
-	"\vListRequest\"G\n" +
+	"\fprocess_cost\x18\x03 \x01(\x01R\vprocessCost\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\x12@\n" +
+	"\varchived_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"archivedAt\x88\x01\x01B\x0e\n" +
+	"\f_archived_at\"\x9e\x01\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"\xa5\x01\n" +
 	"\fListResponse\x127\n" +
 	"\vcargo_types\x18\x01 \x03(\v2\x16.cargotypev1.CargoTypeR\n" +
-	"cargoTypes\"\x1c\n" +
+	"cargoTypes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"G\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"D\n" +
 	"\vGetResponse\x125\n" +
 	"\n" +
 	"cargo_type\x18\x01 \x01(\v2\x16.cargotypev1.CargoTypeR\tcargoType\"H\n" +
@@ -544,23 +720,29 @@ const file_cargotype_cargotype_proto_rawDesc = "" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
 	"\fprocess_cost\x18\x02 \x01(\x01R\vprocessCost\" \n" +
 	"\x0eCreateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"}\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc2\x01\n" +
 	"\rUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12&\n" +
-	"\fprocess_cost\x18\x03 \x01(\x01H\x01R\vprocessCost\x88\x01\x01B\b\n" +
+	"\fprocess_cost\x18\x03 \x01(\x01H\x01R\vprocessCost\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x03H\x02R\x0fexpectedVersion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0f\n" +
-	"\r_process_cost\"\x10\n" +
+	"\r_process_costB\x13\n" +
+	"\x11_expected_version\"\x10\n" +
 	"\x0eUpdateResponse\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
-	"\x0eDeleteResponse2\xd2\x02\n" +
-	"\x10CargoTypeService\x12;\n" +
-	"\x04List\x12\x18.cargotypev1.ListRequest\x1a\x19.cargotypev1.ListResponse\x12A\n" +
-	"\x06Create\x12\x1a.cargotypev1.CreateRequest\x1a\x1b.cargotypev1.CreateResponse\x12A\n" +
-	"\x06Delete\x12\x1a.cargotypev1.DeleteRequest\x1a\x1b.cargotypev1.DeleteResponse\x128\n" +
-	"\x03Get\x12\x17.cargotypev1.GetRequest\x1a\x18.cargotypev1.GetResponse\x12A\n" +
-	"\x06Update\x12\x1a.cargotypev1.UpdateRequest\x1a\x1b.cargotypev1.UpdateResponseB?Z=github.com/deadsnxcks/dbcp/protos/proto/cargotype;cargotypev1b\x06proto3"
+	"\x0eDeleteResponse\" \n" +
+	"\x0eRestoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x11\n" +
+	"\x0fRestoreResponse2\xd3\x04\n" +
+	"\x10CargoTypeService\x12T\n" +
+	"\x04List\x12\x18.cargotypev1.ListRequest\x1a\x19.cargotypev1.ListResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/cargo-types\x12]\n" +
+	"\x06Create\x12\x1a.cargotypev1.CreateRequest\x1a\x1b.cargotypev1.CreateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/cargo-types\x12_\n" +
+	"\x06Delete\x12\x1a.cargotypev1.DeleteRequest\x1a\x1b.cargotypev1.DeleteResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/cargo-types/{id}\x12V\n" +
+	"\x03Get\x12\x17.cargotypev1.GetRequest\x1a\x18.cargotypev1.GetResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cargo-types/{id}\x12b\n" +
+	"\x06Update\x12\x1a.cargotypev1.UpdateRequest\x1a\x1b.cargotypev1.UpdateResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/v1/cargo-types/{id}\x12m\n" +
+	"\aRestore\x12\x1b.cargotypev1.RestoreRequest\x1a\x1c.cargotypev1.RestoreResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/cargo-types/{id}:restoreB?Z=github.com/deadsnxcks/dbcp/protos/proto/cargotype;cargotypev1b\x06proto3"
 
 var (
 	file_cargotype_cargotype_proto_rawDescOnce sync.Once
@@ -574,38 +756,44 @@ func file_cargotype_cargotype_proto_rawDescGZIP() []byte {
 	return file_cargotype_cargotype_proto_rawDescData
 }
 
-var file_cargotype_cargotype_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cargotype_cargotype_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cargotype_cargotype_proto_goTypes = []any{
-	(*CargoType)(nil),      // 0: cargotypev1.CargoType
-	(*ListRequest)(nil),    // 1: cargotypev1.ListRequest
-	(*ListResponse)(nil),   // 2: cargotypev1.ListResponse
-	(*GetRequest)(nil),     // 3: cargotypev1.GetRequest
-	(*GetResponse)(nil),    // 4: cargotypev1.GetResponse
-	(*CreateRequest)(nil),  // 5: cargotypev1.CreateRequest
-	(*CreateResponse)(nil), // 6: cargotypev1.CreateResponse
-	(*UpdateRequest)(nil),  // 7: cargotypev1.UpdateRequest
-	(*UpdateResponse)(nil), // 8: cargotypev1.UpdateResponse
-	(*DeleteRequest)(nil),  // 9: cargotypev1.DeleteRequest
-	(*DeleteResponse)(nil), // 10: cargotypev1.DeleteResponse
+	(*CargoType)(nil),             // 0: cargotypev1.CargoType
+	(*ListRequest)(nil),           // 1: cargotypev1.ListRequest
+	(*ListResponse)(nil),          // 2: cargotypev1.ListResponse
+	(*GetRequest)(nil),            // 3: cargotypev1.GetRequest
+	(*GetResponse)(nil),           // 4: cargotypev1.GetResponse
+	(*CreateRequest)(nil),         // 5: cargotypev1.CreateRequest
+	(*CreateResponse)(nil),        // 6: cargotypev1.CreateResponse
+	(*UpdateRequest)(nil),         // 7: cargotypev1.UpdateRequest
+	(*UpdateResponse)(nil),        // 8: cargotypev1.UpdateResponse
+	(*DeleteRequest)(nil),         // 9: cargotypev1.DeleteRequest
+	(*DeleteResponse)(nil),        // 10: cargotypev1.DeleteResponse
+	(*RestoreRequest)(nil),        // 11: cargotypev1.RestoreRequest
+	(*RestoreResponse)(nil),       // 12: cargotypev1.RestoreResponse
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
 }
 var file_cargotype_cargotype_proto_depIdxs = []int32{
-	0,  // 0: cargotypev1.ListResponse.cargo_types:type_name -> cargotypev1.CargoType
-	0,  // 1: cargotypev1.GetResponse.cargo_type:type_name -> cargotypev1.CargoType
-	1,  // 2: cargotypev1.CargoTypeService.List:input_type -> cargotypev1.ListRequest
-	5,  // 3: cargotypev1.CargoTypeService.Create:input_type -> cargotypev1.CreateRequest
-	9,  // 4: cargotypev1.CargoTypeService.Delete:input_type -> cargotypev1.DeleteRequest
-	3,  // 5: cargotypev1.CargoTypeService.Get:input_type -> cargotypev1.GetRequest
-	7,  // 6: cargotypev1.CargoTypeService.Update:input_type -> cargotypev1.UpdateRequest
-	2,  // 7: cargotypev1.CargoTypeService.List:output_type -> cargotypev1.ListResponse
-	6,  // 8: cargotypev1.CargoTypeService.Create:output_type -> cargotypev1.CreateResponse
-	10, // 9: cargotypev1.CargoTypeService.Delete:output_type -> cargotypev1.DeleteResponse
-	4,  // 10: cargotypev1.CargoTypeService.Get:output_type -> cargotypev1.GetResponse
-	8,  // 11: cargotypev1.CargoTypeService.Update:output_type -> cargotypev1.UpdateResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	13, // 0: cargotypev1.CargoType.archived_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: cargotypev1.ListResponse.cargo_types:type_name -> cargotypev1.CargoType
+	0,  // 2: cargotypev1.GetResponse.cargo_type:type_name -> cargotypev1.CargoType
+	1,  // 3: cargotypev1.CargoTypeService.List:input_type -> cargotypev1.ListRequest
+	5,  // 4: cargotypev1.CargoTypeService.Create:input_type -> cargotypev1.CreateRequest
+	9,  // 5: cargotypev1.CargoTypeService.Delete:input_type -> cargotypev1.DeleteRequest
+	3,  // 6: cargotypev1.CargoTypeService.Get:input_type -> cargotypev1.GetRequest
+	7,  // 7: cargotypev1.CargoTypeService.Update:input_type -> cargotypev1.UpdateRequest
+	11, // 8: cargotypev1.CargoTypeService.Restore:input_type -> cargotypev1.RestoreRequest
+	2,  // 9: cargotypev1.CargoTypeService.List:output_type -> cargotypev1.ListResponse
+	6,  // 10: cargotypev1.CargoTypeService.Create:output_type -> cargotypev1.CreateResponse
+	10, // 11: cargotypev1.CargoTypeService.Delete:output_type -> cargotypev1.DeleteResponse
+	4,  // 12: cargotypev1.CargoTypeService.Get:output_type -> cargotypev1.GetResponse
+	8,  // 13: cargotypev1.CargoTypeService.Update:output_type -> cargotypev1.UpdateResponse
+	12, // 14: cargotypev1.CargoTypeService.Restore:output_type -> cargotypev1.RestoreResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cargotype_cargotype_proto_init() }
@@ -613,6 +801,8 @@ func file_cargotype_cargotype_proto_init() {
 	if File_cargotype_cargotype_proto != nil {
 		return
 	}
+	file_cargotype_cargotype_proto_msgTypes[0].OneofWrappers = []any{}
+	file_cargotype_cargotype_proto_msgTypes[2].OneofWrappers = []any{}
 	file_cargotype_cargotype_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -620,7 +810,7 @@ func file_cargotype_cargotype_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cargotype_cargotype_proto_rawDesc), len(file_cargotype_cargotype_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CargoTypeService_List_FullMethodName   = "/cargotypev1.CargoTypeService/List"
-	CargoTypeService_Create_FullMethodName = "/cargotypev1.CargoTypeService/Create"
-	CargoTypeService_Delete_FullMethodName = "/cargotypev1.CargoTypeService/Delete"
-	CargoTypeService_Get_FullMethodName    = "/cargotypev1.CargoTypeService/Get"
-	CargoTypeService_Update_FullMethodName = "/cargotypev1.CargoTypeService/Update"
+	CargoTypeService_List_FullMethodName    = "/cargotypev1.CargoTypeService/List"
+	CargoTypeService_Create_FullMethodName  = "/cargotypev1.CargoTypeService/Create"
+	CargoTypeService_Delete_FullMethodName  = "/cargotypev1.CargoTypeService/Delete"
+	CargoTypeService_Get_FullMethodName     = "/cargotypev1.CargoTypeService/Get"
+	CargoTypeService_Update_FullMethodName  = "/cargotypev1.CargoTypeService/Update"
+	CargoTypeService_Restore_FullMethodName = "/cargotypev1.CargoTypeService/Restore"
 )
 
 // CargoTypeServiceClient is the client API for CargoTypeService service.
@@ -35,6 +36,7 @@ type CargoTypeServiceClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
 }
 
 type cargoTypeServiceClient struct {
@@ -95,6 +97,16 @@ func (c *cargoTypeServiceClient) Update(ctx context.Context, in *UpdateRequest,
 	return out, nil
 }
 
+func (c *cargoTypeServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, CargoTypeService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CargoTypeServiceServer is the server API for CargoTypeService service.
 // All implementations must embed UnimplementedCargoTypeServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type CargoTypeServiceServer interface {
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
 	mustEmbedUnimplementedCargoTypeServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedCargoTypeServiceServer) Get(context.Context, *GetRequest) (*G
 func (UnimplementedCargoTypeServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedCargoTypeServiceServer) Restore(context.Context, *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedCargoTypeServiceServer) mustEmbedUnimplementedCargoTypeServiceServer() {}
 func (UnimplementedCargoTypeServiceServer) testEmbeddedByValue()                          {}
 
@@ -240,6 +256,24 @@ func _CargoTypeService_Update_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CargoTypeService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CargoTypeServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CargoTypeService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CargoTypeServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CargoTypeService_ServiceDesc is the grpc.ServiceDesc for CargoTypeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var CargoTypeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _CargoTypeService_Update_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _CargoTypeService_Restore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cargotype/cargotype.proto",
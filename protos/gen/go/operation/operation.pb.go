@@ -7,6 +7,7 @@
 package operationv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,11 +23,86 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OperationKind int32
+
+const (
+	OperationKind_OPERATION_KIND_UNSPECIFIED       OperationKind = 0
+	OperationKind_OPERATION_KIND_UNLOADING         OperationKind = 1
+	OperationKind_OPERATION_KIND_LOADING           OperationKind = 2
+	OperationKind_OPERATION_KIND_PLACEMENT         OperationKind = 3
+	OperationKind_OPERATION_KIND_RELEASE           OperationKind = 4
+	OperationKind_OPERATION_KIND_TRANSFER          OperationKind = 5
+	OperationKind_OPERATION_KIND_INSPECTION        OperationKind = 6
+	OperationKind_OPERATION_KIND_ARRIVAL           OperationKind = 7
+	OperationKind_OPERATION_KIND_DEPARTURE         OperationKind = 8
+	OperationKind_OPERATION_KIND_CUSTOMS_CLEARANCE OperationKind = 9
+	OperationKind_OPERATION_KIND_OTHER             OperationKind = 10
+)
+
+// Enum value maps for OperationKind.
+var (
+	OperationKind_name = map[int32]string{
+		0:  "OPERATION_KIND_UNSPECIFIED",
+		1:  "OPERATION_KIND_UNLOADING",
+		2:  "OPERATION_KIND_LOADING",
+		3:  "OPERATION_KIND_PLACEMENT",
+		4:  "OPERATION_KIND_RELEASE",
+		5:  "OPERATION_KIND_TRANSFER",
+		6:  "OPERATION_KIND_INSPECTION",
+		7:  "OPERATION_KIND_ARRIVAL",
+		8:  "OPERATION_KIND_DEPARTURE",
+		9:  "OPERATION_KIND_CUSTOMS_CLEARANCE",
+		10: "OPERATION_KIND_OTHER",
+	}
+	OperationKind_value = map[string]int32{
+		"OPERATION_KIND_UNSPECIFIED":       0,
+		"OPERATION_KIND_UNLOADING":         1,
+		"OPERATION_KIND_LOADING":           2,
+		"OPERATION_KIND_PLACEMENT":         3,
+		"OPERATION_KIND_RELEASE":           4,
+		"OPERATION_KIND_TRANSFER":          5,
+		"OPERATION_KIND_INSPECTION":        6,
+		"OPERATION_KIND_ARRIVAL":           7,
+		"OPERATION_KIND_DEPARTURE":         8,
+		"OPERATION_KIND_CUSTOMS_CLEARANCE": 9,
+		"OPERATION_KIND_OTHER":             10,
+	}
+)
+
+func (x OperationKind) Enum() *OperationKind {
+	p := new(OperationKind)
+	*p = x
+	return p
+}
+
+func (x OperationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_operation_operation_proto_enumTypes[0].Descriptor()
+}
+
+func (OperationKind) Type() protoreflect.EnumType {
+	return &file_operation_operation_proto_enumTypes[0]
+}
+
+func (x OperationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationKind.Descriptor instead.
+func (OperationKind) EnumDescriptor() ([]byte, []int) {
+	return file_operation_operation_proto_rawDescGZIP(), []int{0}
+}
+
 type Operation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Kind          OperationKind          `protobuf:"varint,4,opt,name=kind,proto3,enum=operationv1.OperationKind" json:"kind,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,10 +158,27 @@ func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Operation) GetKind() OperationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return OperationKind_OPERATION_KIND_UNSPECIFIED
+}
+
+func (x *Operation) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
@@ -118,9 +211,32 @@ func (*ListRequest) Descriptor() ([]byte, []int) {
 	return file_operation_operation_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
 type ListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Operations    []*Operation           `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,6 +278,20 @@ func (x *ListResponse) GetOperations() []*Operation {
 	return nil
 }
 
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 type GetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -253,6 +383,7 @@ func (x *GetResponse) GetOperation() *Operation {
 type CreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Kind          OperationKind          `protobuf:"varint,2,opt,name=kind,proto3,enum=operationv1.OperationKind" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,6 +425,13 @@ func (x *CreateRequest) GetTitle() string {
 	return ""
 }
 
+func (x *CreateRequest) GetKind() OperationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return OperationKind_OPERATION_KIND_UNSPECIFIED
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -339,11 +477,13 @@ func (x *CreateResponse) GetId() int64 {
 }
 
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Kind            OperationKind          `protobuf:"varint,3,opt,name=kind,proto3,enum=operationv1.OperationKind" json:"kind,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
@@ -390,6 +530,20 @@ func (x *UpdateRequest) GetTitle() string {
 	return ""
 }
 
+func (x *UpdateRequest) GetKind() OperationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return OperationKind_OPERATION_KIND_UNSPECIFIED
+}
+
+func (x *UpdateRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -510,40 +664,67 @@ var File_operation_operation_proto protoreflect.FileDescriptor
 
 const file_operation_operation_proto_rawDesc = "" +
 	"\n" +
-	"\x19operation/operation.proto\x12\voperationv1\x1a\x1fgoogle/protobuf/timestamp.proto\"l\n" +
+	"\x19operation/operation.proto\x12\voperationv1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x01\n" +
 	"\tOperation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\r\n" +
-	"\vListRequest\"F\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12.\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1a.operationv1.OperationKindR\x04kind\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"s\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\"\xa4\x01\n" +
 	"\fListResponse\x126\n" +
 	"\n" +
 	"operations\x18\x01 \x03(\v2\x16.operationv1.OperationR\n" +
-	"operations\"\x1c\n" +
+	"operations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x1c\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"C\n" +
 	"\vGetResponse\x124\n" +
-	"\toperation\x18\x01 \x01(\v2\x16.operationv1.OperationR\toperation\"%\n" +
+	"\toperation\x18\x01 \x01(\v2\x16.operationv1.OperationR\toperation\"U\n" +
 	"\rCreateRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\" \n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12.\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1a.operationv1.OperationKindR\x04kind\" \n" +
 	"\x0eCreateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb9\x01\n" +
 	"\rUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01B\b\n" +
-	"\x06_title\"\x10\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12.\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1a.operationv1.OperationKindR\x04kind\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x03H\x01R\x0fexpectedVersion\x88\x01\x01B\b\n" +
+	"\x06_titleB\x13\n" +
+	"\x11_expected_version\"\x10\n" +
 	"\x0eUpdateResponse\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
-	"\x0eDeleteResponse2\xd2\x02\n" +
-	"\x10OperationService\x12;\n" +
-	"\x04List\x12\x18.operationv1.ListRequest\x1a\x19.operationv1.ListResponse\x12A\n" +
-	"\x06Create\x12\x1a.operationv1.CreateRequest\x1a\x1b.operationv1.CreateResponse\x12A\n" +
-	"\x06Delete\x12\x1a.operationv1.DeleteRequest\x1a\x1b.operationv1.DeleteResponse\x128\n" +
-	"\x03Get\x12\x17.operationv1.GetRequest\x1a\x18.operationv1.GetResponse\x12A\n" +
-	"\x06Update\x12\x1a.operationv1.UpdateRequest\x1a\x1b.operationv1.UpdateResponseB?Z=github.com/deadsnxcks/dbcp/protos/proto/operation;operationv1b\x06proto3"
+	"\x0eDeleteResponse*\xd9\x02\n" +
+	"\rOperationKind\x12\x1e\n" +
+	"\x1aOPERATION_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18OPERATION_KIND_UNLOADING\x10\x01\x12\x1a\n" +
+	"\x16OPERATION_KIND_LOADING\x10\x02\x12\x1c\n" +
+	"\x18OPERATION_KIND_PLACEMENT\x10\x03\x12\x1a\n" +
+	"\x16OPERATION_KIND_RELEASE\x10\x04\x12\x1b\n" +
+	"\x17OPERATION_KIND_TRANSFER\x10\x05\x12\x1d\n" +
+	"\x19OPERATION_KIND_INSPECTION\x10\x06\x12\x1a\n" +
+	"\x16OPERATION_KIND_ARRIVAL\x10\a\x12\x1c\n" +
+	"\x18OPERATION_KIND_DEPARTURE\x10\b\x12$\n" +
+	" OPERATION_KIND_CUSTOMS_CLEARANCE\x10\t\x12\x18\n" +
+	"\x14OPERATION_KIND_OTHER\x10\n" +
+	"2\xdf\x03\n" +
+	"\x10OperationService\x12S\n" +
+	"\x04List\x12\x18.operationv1.ListRequest\x1a\x19.operationv1.ListResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/operations\x12\\\n" +
+	"\x06Create\x12\x1a.operationv1.CreateRequest\x1a\x1b.operationv1.CreateResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/operations\x12^\n" +
+	"\x06Delete\x12\x1a.operationv1.DeleteRequest\x1a\x1b.operationv1.DeleteResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/operations/{id}\x12U\n" +
+	"\x03Get\x12\x17.operationv1.GetRequest\x1a\x18.operationv1.GetResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/operations/{id}\x12a\n" +
+	"\x06Update\x12\x1a.operationv1.UpdateRequest\x1a\x1b.operationv1.UpdateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/operations/{id}B?Z=github.com/deadsnxcks/dbcp/protos/proto/operation;operationv1b\x06proto3"
 
 var (
 	file_operation_operation_proto_rawDescOnce sync.Once
@@ -557,40 +738,45 @@ func file_operation_operation_proto_rawDescGZIP() []byte {
 	return file_operation_operation_proto_rawDescData
 }
 
+var file_operation_operation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_operation_operation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_operation_operation_proto_goTypes = []any{
-	(*Operation)(nil),             // 0: operationv1.Operation
-	(*ListRequest)(nil),           // 1: operationv1.ListRequest
-	(*ListResponse)(nil),          // 2: operationv1.ListResponse
-	(*GetRequest)(nil),            // 3: operationv1.GetRequest
-	(*GetResponse)(nil),           // 4: operationv1.GetResponse
-	(*CreateRequest)(nil),         // 5: operationv1.CreateRequest
-	(*CreateResponse)(nil),        // 6: operationv1.CreateResponse
-	(*UpdateRequest)(nil),         // 7: operationv1.UpdateRequest
-	(*UpdateResponse)(nil),        // 8: operationv1.UpdateResponse
-	(*DeleteRequest)(nil),         // 9: operationv1.DeleteRequest
-	(*DeleteResponse)(nil),        // 10: operationv1.DeleteResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(OperationKind)(0),            // 0: operationv1.OperationKind
+	(*Operation)(nil),             // 1: operationv1.Operation
+	(*ListRequest)(nil),           // 2: operationv1.ListRequest
+	(*ListResponse)(nil),          // 3: operationv1.ListResponse
+	(*GetRequest)(nil),            // 4: operationv1.GetRequest
+	(*GetResponse)(nil),           // 5: operationv1.GetResponse
+	(*CreateRequest)(nil),         // 6: operationv1.CreateRequest
+	(*CreateResponse)(nil),        // 7: operationv1.CreateResponse
+	(*UpdateRequest)(nil),         // 8: operationv1.UpdateRequest
+	(*UpdateResponse)(nil),        // 9: operationv1.UpdateResponse
+	(*DeleteRequest)(nil),         // 10: operationv1.DeleteRequest
+	(*DeleteResponse)(nil),        // 11: operationv1.DeleteResponse
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_operation_operation_proto_depIdxs = []int32{
-	11, // 0: operationv1.Operation.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: operationv1.ListResponse.operations:type_name -> operationv1.Operation
-	0,  // 2: operationv1.GetResponse.operation:type_name -> operationv1.Operation
-	1,  // 3: operationv1.OperationService.List:input_type -> operationv1.ListRequest
-	5,  // 4: operationv1.OperationService.Create:input_type -> operationv1.CreateRequest
-	9,  // 5: operationv1.OperationService.Delete:input_type -> operationv1.DeleteRequest
-	3,  // 6: operationv1.OperationService.Get:input_type -> operationv1.GetRequest
-	7,  // 7: operationv1.OperationService.Update:input_type -> operationv1.UpdateRequest
-	2,  // 8: operationv1.OperationService.List:output_type -> operationv1.ListResponse
-	6,  // 9: operationv1.OperationService.Create:output_type -> operationv1.CreateResponse
-	10, // 10: operationv1.OperationService.Delete:output_type -> operationv1.DeleteResponse
-	4,  // 11: operationv1.OperationService.Get:output_type -> operationv1.GetResponse
-	8,  // 12: operationv1.OperationService.Update:output_type -> operationv1.UpdateResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	12, // 0: operationv1.Operation.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: operationv1.Operation.kind:type_name -> operationv1.OperationKind
+	1,  // 2: operationv1.ListResponse.operations:type_name -> operationv1.Operation
+	1,  // 3: operationv1.GetResponse.operation:type_name -> operationv1.Operation
+	0,  // 4: operationv1.CreateRequest.kind:type_name -> operationv1.OperationKind
+	0,  // 5: operationv1.UpdateRequest.kind:type_name -> operationv1.OperationKind
+	2,  // 6: operationv1.OperationService.List:input_type -> operationv1.ListRequest
+	6,  // 7: operationv1.OperationService.Create:input_type -> operationv1.CreateRequest
+	10, // 8: operationv1.OperationService.Delete:input_type -> operationv1.DeleteRequest
+	4,  // 9: operationv1.OperationService.Get:input_type -> operationv1.GetRequest
+	8,  // 10: operationv1.OperationService.Update:input_type -> operationv1.UpdateRequest
+	3,  // 11: operationv1.OperationService.List:output_type -> operationv1.ListResponse
+	7,  // 12: operationv1.OperationService.Create:output_type -> operationv1.CreateResponse
+	11, // 13: operationv1.OperationService.Delete:output_type -> operationv1.DeleteResponse
+	5,  // 14: operationv1.OperationService.Get:output_type -> operationv1.GetResponse
+	9,  // 15: operationv1.OperationService.Update:output_type -> operationv1.UpdateResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_operation_operation_proto_init() }
@@ -598,19 +784,21 @@ func file_operation_operation_proto_init() {
 	if File_operation_operation_proto != nil {
 		return
 	}
+	file_operation_operation_proto_msgTypes[2].OneofWrappers = []any{}
 	file_operation_operation_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_operation_operation_proto_rawDesc), len(file_operation_operation_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_operation_operation_proto_goTypes,
 		DependencyIndexes: file_operation_operation_proto_depIdxs,
+		EnumInfos:         file_operation_operation_proto_enumTypes,
 		MessageInfos:      file_operation_operation_proto_msgTypes,
 	}.Build()
 	File_operation_operation_proto = out.File
@@ -7,6 +7,7 @@
 package opercargov1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -74,9 +75,12 @@ func (x *OperationCargo) GetCargoId() int64 {
 }
 
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
@@ -109,9 +113,32 @@ func (*ListRequest) Descriptor() ([]byte, []int) {
 	return file_opercargo_opercargo_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
 type ListResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	OperationsCargos []*OperationCargo      `protobuf:"bytes,1,rep,name=operations_cargos,json=operationsCargos,proto3" json:"operations_cargos,omitempty"`
+	NextPageToken    string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount       *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -153,6 +180,20 @@ func (x *ListResponse) GetOperationsCargos() []*OperationCargo {
 	return nil
 }
 
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 type CreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OperationId   int64                  `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
@@ -333,13 +374,21 @@ var File_opercargo_opercargo_proto protoreflect.FileDescriptor
 
 const file_opercargo_opercargo_proto_rawDesc = "" +
 	"\n" +
-	"\x19opercargo/opercargo.proto\x12\vopercargov1\"N\n" +
+	"\x19opercargo/opercargo.proto\x12\vopercargov1\x1a\x1cgoogle/api/annotations.proto\"N\n" +
 	"\x0eOperationCargo\x12!\n" +
 	"\foperation_id\x18\x01 \x01(\x03R\voperationId\x12\x19\n" +
-	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\"\r\n" +
-	"\vListRequest\"X\n" +
+	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\"s\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\"\xb6\x01\n" +
 	"\fListResponse\x12H\n" +
-	"\x11operations_cargos\x18\x01 \x03(\v2\x1b.opercargov1.OperationCargoR\x10operationsCargos\"M\n" +
+	"\x11operations_cargos\x18\x01 \x03(\v2\x1b.opercargov1.OperationCargoR\x10operationsCargos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"M\n" +
 	"\rCreateRequest\x12!\n" +
 	"\foperation_id\x18\x01 \x01(\x03R\voperationId\x12\x19\n" +
 	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\"\x10\n" +
@@ -347,11 +396,11 @@ const file_opercargo_opercargo_proto_rawDesc = "" +
 	"\rDeleteRequest\x12!\n" +
 	"\foperation_id\x18\x01 \x01(\x03R\voperationId\x12\x19\n" +
 	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\"\x10\n" +
-	"\x0eDeleteResponse2\xda\x01\n" +
-	"\x15OperationCargoService\x12;\n" +
-	"\x04List\x12\x18.opercargov1.ListRequest\x1a\x19.opercargov1.ListResponse\x12A\n" +
-	"\x06Create\x12\x1a.opercargov1.CreateRequest\x1a\x1b.opercargov1.CreateResponse\x12A\n" +
-	"\x06Delete\x12\x1a.opercargov1.DeleteRequest\x1a\x1b.opercargov1.DeleteResponseB?Z=github.com/deadsnxcks/dbcp/protos/proto/opercargo;opercargov1b\x06proto3"
+	"\x0eDeleteResponse2\xe2\x02\n" +
+	"\x15OperationCargoService\x12Y\n" +
+	"\x04List\x12\x18.opercargov1.ListRequest\x1a\x19.opercargov1.ListResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/operation-cargos\x12r\n" +
+	"\x06Create\x12\x1a.opercargov1.CreateRequest\x1a\x1b.opercargov1.CreateResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/operations/{operation_id}/cargos\x12z\n" +
+	"\x06Delete\x12\x1a.opercargov1.DeleteRequest\x1a\x1b.opercargov1.DeleteResponse\"7\x82\xd3\xe4\x93\x021*//v1/operations/{operation_id}/cargos/{cargo_id}B?Z=github.com/deadsnxcks/dbcp/protos/proto/opercargo;opercargov1b\x06proto3"
 
 var (
 	file_opercargo_opercargo_proto_rawDescOnce sync.Once
@@ -395,6 +444,7 @@ func file_opercargo_opercargo_proto_init() {
 	if File_opercargo_opercargo_proto != nil {
 		return
 	}
+	file_opercargo_opercargo_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
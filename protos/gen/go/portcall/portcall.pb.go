@@ -0,0 +1,1131 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: portcall/portcall.proto
+
+package portcallv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PortCallStatus int32
+
+const (
+	PortCallStatus_PORT_CALL_STATUS_UNSPECIFIED PortCallStatus = 0
+	PortCallStatus_PORT_CALL_STATUS_SCHEDULED   PortCallStatus = 1
+	PortCallStatus_PORT_CALL_STATUS_ARRIVED     PortCallStatus = 2
+	PortCallStatus_PORT_CALL_STATUS_DEPARTED    PortCallStatus = 3
+	PortCallStatus_PORT_CALL_STATUS_CANCELLED   PortCallStatus = 4
+)
+
+// Enum value maps for PortCallStatus.
+var (
+	PortCallStatus_name = map[int32]string{
+		0: "PORT_CALL_STATUS_UNSPECIFIED",
+		1: "PORT_CALL_STATUS_SCHEDULED",
+		2: "PORT_CALL_STATUS_ARRIVED",
+		3: "PORT_CALL_STATUS_DEPARTED",
+		4: "PORT_CALL_STATUS_CANCELLED",
+	}
+	PortCallStatus_value = map[string]int32{
+		"PORT_CALL_STATUS_UNSPECIFIED": 0,
+		"PORT_CALL_STATUS_SCHEDULED":   1,
+		"PORT_CALL_STATUS_ARRIVED":     2,
+		"PORT_CALL_STATUS_DEPARTED":    3,
+		"PORT_CALL_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x PortCallStatus) Enum() *PortCallStatus {
+	p := new(PortCallStatus)
+	*p = x
+	return p
+}
+
+func (x PortCallStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortCallStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_portcall_portcall_proto_enumTypes[0].Descriptor()
+}
+
+func (PortCallStatus) Type() protoreflect.EnumType {
+	return &file_portcall_portcall_proto_enumTypes[0]
+}
+
+func (x PortCallStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortCallStatus.Descriptor instead.
+func (PortCallStatus) EnumDescriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{0}
+}
+
+type PortCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VesselId      int64                  `protobuf:"varint,2,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	VoyageNumber  string                 `protobuf:"bytes,3,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Status        PortCallStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=portcallv1.PortCallStatus" json:"status,omitempty"`
+	Eta           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=eta,proto3" json:"eta,omitempty"`
+	Etd           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=etd,proto3" json:"etd,omitempty"`
+	Ata           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ata,proto3,oneof" json:"ata,omitempty"`
+	Atd           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=atd,proto3,oneof" json:"atd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortCall) Reset() {
+	*x = PortCall{}
+	mi := &file_portcall_portcall_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortCall) ProtoMessage() {}
+
+func (x *PortCall) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortCall.ProtoReflect.Descriptor instead.
+func (*PortCall) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PortCall) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PortCall) GetVesselId() int64 {
+	if x != nil {
+		return x.VesselId
+	}
+	return 0
+}
+
+func (x *PortCall) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *PortCall) GetStatus() PortCallStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PortCallStatus_PORT_CALL_STATUS_UNSPECIFIED
+}
+
+func (x *PortCall) GetEta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *PortCall) GetEtd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Etd
+	}
+	return nil
+}
+
+func (x *PortCall) GetAta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ata
+	}
+	return nil
+}
+
+func (x *PortCall) GetAtd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Atd
+	}
+	return nil
+}
+
+type ListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	VesselId       *int64                 `protobuf:"varint,4,opt,name=vessel_id,json=vesselId,proto3,oneof" json:"vessel_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListRequest) GetVesselId() int64 {
+	if x != nil && x.VesselId != nil {
+		return *x.VesselId
+	}
+	return 0
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortCalls     []*PortCall            `protobuf:"bytes,1,rep,name=port_calls,json=portCalls,proto3" json:"port_calls,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListResponse) GetPortCalls() []*PortCall {
+	if x != nil {
+		return x.PortCalls
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortCall      *PortCall              `protobuf:"bytes,1,opt,name=port_call,json=portCall,proto3" json:"port_call,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetResponse) GetPortCall() *PortCall {
+	if x != nil {
+		return x.PortCall
+	}
+	return nil
+}
+
+type CreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VesselId      int64                  `protobuf:"varint,1,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	VoyageNumber  string                 `protobuf:"bytes,2,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Eta           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=eta,proto3" json:"eta,omitempty"`
+	Etd           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=etd,proto3" json:"etd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateRequest) GetVesselId() int64 {
+	if x != nil {
+		return x.VesselId
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetEta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetEtd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Etd
+	}
+	return nil
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VoyageNumber  *string                `protobuf:"bytes,2,opt,name=voyage_number,json=voyageNumber,proto3,oneof" json:"voyage_number,omitempty"`
+	Eta           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=eta,proto3,oneof" json:"eta,omitempty"`
+	Etd           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=etd,proto3,oneof" json:"etd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetVoyageNumber() string {
+	if x != nil && x.VoyageNumber != nil {
+		return *x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetEta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetEtd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Etd
+	}
+	return nil
+}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{8}
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{10}
+}
+
+type ArriveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ata           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ata,proto3" json:"ata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArriveRequest) Reset() {
+	*x = ArriveRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArriveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArriveRequest) ProtoMessage() {}
+
+func (x *ArriveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArriveRequest.ProtoReflect.Descriptor instead.
+func (*ArriveRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ArriveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArriveRequest) GetAta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ata
+	}
+	return nil
+}
+
+type ArriveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArriveResponse) Reset() {
+	*x = ArriveResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArriveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArriveResponse) ProtoMessage() {}
+
+func (x *ArriveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArriveResponse.ProtoReflect.Descriptor instead.
+func (*ArriveResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{12}
+}
+
+type DepartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Atd           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=atd,proto3" json:"atd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartRequest) Reset() {
+	*x = DepartRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartRequest) ProtoMessage() {}
+
+func (x *DepartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartRequest.ProtoReflect.Descriptor instead.
+func (*DepartRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DepartRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DepartRequest) GetAtd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Atd
+	}
+	return nil
+}
+
+type DepartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartResponse) Reset() {
+	*x = DepartResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartResponse) ProtoMessage() {}
+
+func (x *DepartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartResponse.ProtoReflect.Descriptor instead.
+func (*DepartResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{14}
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_portcall_portcall_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_portcall_portcall_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portcall_portcall_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_portcall_portcall_proto_rawDescGZIP(), []int{16}
+}
+
+var File_portcall_portcall_proto protoreflect.FileDescriptor
+
+const file_portcall_portcall_proto_rawDesc = "" +
+	"\n" +
+	"\x17portcall/portcall.proto\x12\n" +
+	"portcallv1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x02\n" +
+	"\bPortCall\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tvessel_id\x18\x02 \x01(\x03R\bvesselId\x12#\n" +
+	"\rvoyage_number\x18\x03 \x01(\tR\fvoyageNumber\x122\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1a.portcallv1.PortCallStatusR\x06status\x12,\n" +
+	"\x03eta\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x03eta\x12,\n" +
+	"\x03etd\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03etd\x121\n" +
+	"\x03ata\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x03ata\x88\x01\x01\x121\n" +
+	"\x03atd\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x03atd\x88\x01\x01B\x06\n" +
+	"\x04_ataB\x06\n" +
+	"\x04_atd\"\xa3\x01\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\x12 \n" +
+	"\tvessel_id\x18\x04 \x01(\x03H\x00R\bvesselId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_vessel_id\"\xa1\x01\n" +
+	"\fListResponse\x123\n" +
+	"\n" +
+	"port_calls\x18\x01 \x03(\v2\x14.portcallv1.PortCallR\tportCalls\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x1c\n" +
+	"\n" +
+	"GetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"@\n" +
+	"\vGetResponse\x121\n" +
+	"\tport_call\x18\x01 \x01(\v2\x14.portcallv1.PortCallR\bportCall\"\xad\x01\n" +
+	"\rCreateRequest\x12\x1b\n" +
+	"\tvessel_id\x18\x01 \x01(\x03R\bvesselId\x12#\n" +
+	"\rvoyage_number\x18\x02 \x01(\tR\fvoyageNumber\x12,\n" +
+	"\x03eta\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03eta\x12,\n" +
+	"\x03etd\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03etd\" \n" +
+	"\x0eCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xd1\x01\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
+	"\rvoyage_number\x18\x02 \x01(\tH\x00R\fvoyageNumber\x88\x01\x01\x121\n" +
+	"\x03eta\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x03eta\x88\x01\x01\x121\n" +
+	"\x03etd\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x03etd\x88\x01\x01B\x10\n" +
+	"\x0e_voyage_numberB\x06\n" +
+	"\x04_etaB\x06\n" +
+	"\x04_etd\"\x10\n" +
+	"\x0eUpdateResponse\"\x1f\n" +
+	"\rDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
+	"\x0eDeleteResponse\"M\n" +
+	"\rArriveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x03ata\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03ata\"\x10\n" +
+	"\x0eArriveResponse\"M\n" +
+	"\rDepartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x03atd\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03atd\"\x10\n" +
+	"\x0eDepartResponse\"\x1f\n" +
+	"\rCancelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
+	"\x0eCancelResponse*\xaf\x01\n" +
+	"\x0ePortCallStatus\x12 \n" +
+	"\x1cPORT_CALL_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPORT_CALL_STATUS_SCHEDULED\x10\x01\x12\x1c\n" +
+	"\x18PORT_CALL_STATUS_ARRIVED\x10\x02\x12\x1d\n" +
+	"\x19PORT_CALL_STATUS_DEPARTED\x10\x03\x12\x1e\n" +
+	"\x1aPORT_CALL_STATUS_CANCELLED\x10\x042\x8c\x06\n" +
+	"\x0fPortCallService\x12Q\n" +
+	"\x04List\x12\x17.portcallv1.ListRequest\x1a\x18.portcallv1.ListResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/port-calls\x12S\n" +
+	"\x03Get\x12\x16.portcallv1.GetRequest\x1a\x17.portcallv1.GetResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/port-calls/{id}\x12Z\n" +
+	"\x06Create\x12\x19.portcallv1.CreateRequest\x1a\x1a.portcallv1.CreateResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/port-calls\x12_\n" +
+	"\x06Update\x12\x19.portcallv1.UpdateRequest\x1a\x1a.portcallv1.UpdateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/port-calls/{id}\x12\\\n" +
+	"\x06Delete\x12\x19.portcallv1.DeleteRequest\x1a\x1a.portcallv1.DeleteResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/port-calls/{id}\x12f\n" +
+	"\x06Arrive\x12\x19.portcallv1.ArriveRequest\x1a\x1a.portcallv1.ArriveResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/port-calls/{id}:arrive\x12f\n" +
+	"\x06Depart\x12\x19.portcallv1.DepartRequest\x1a\x1a.portcallv1.DepartResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/port-calls/{id}:depart\x12f\n" +
+	"\x06Cancel\x12\x19.portcallv1.CancelRequest\x1a\x1a.portcallv1.CancelResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/port-calls/{id}:cancelB=Z;github.com/deadsnxcks/dbcp/protos/proto/portcall;portcallv1b\x06proto3"
+
+var (
+	file_portcall_portcall_proto_rawDescOnce sync.Once
+	file_portcall_portcall_proto_rawDescData []byte
+)
+
+func file_portcall_portcall_proto_rawDescGZIP() []byte {
+	file_portcall_portcall_proto_rawDescOnce.Do(func() {
+		file_portcall_portcall_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_portcall_portcall_proto_rawDesc), len(file_portcall_portcall_proto_rawDesc)))
+	})
+	return file_portcall_portcall_proto_rawDescData
+}
+
+var file_portcall_portcall_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_portcall_portcall_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_portcall_portcall_proto_goTypes = []any{
+	(PortCallStatus)(0),           // 0: portcallv1.PortCallStatus
+	(*PortCall)(nil),              // 1: portcallv1.PortCall
+	(*ListRequest)(nil),           // 2: portcallv1.ListRequest
+	(*ListResponse)(nil),          // 3: portcallv1.ListResponse
+	(*GetRequest)(nil),            // 4: portcallv1.GetRequest
+	(*GetResponse)(nil),           // 5: portcallv1.GetResponse
+	(*CreateRequest)(nil),         // 6: portcallv1.CreateRequest
+	(*CreateResponse)(nil),        // 7: portcallv1.CreateResponse
+	(*UpdateRequest)(nil),         // 8: portcallv1.UpdateRequest
+	(*UpdateResponse)(nil),        // 9: portcallv1.UpdateResponse
+	(*DeleteRequest)(nil),         // 10: portcallv1.DeleteRequest
+	(*DeleteResponse)(nil),        // 11: portcallv1.DeleteResponse
+	(*ArriveRequest)(nil),         // 12: portcallv1.ArriveRequest
+	(*ArriveResponse)(nil),        // 13: portcallv1.ArriveResponse
+	(*DepartRequest)(nil),         // 14: portcallv1.DepartRequest
+	(*DepartResponse)(nil),        // 15: portcallv1.DepartResponse
+	(*CancelRequest)(nil),         // 16: portcallv1.CancelRequest
+	(*CancelResponse)(nil),        // 17: portcallv1.CancelResponse
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+}
+var file_portcall_portcall_proto_depIdxs = []int32{
+	0,  // 0: portcallv1.PortCall.status:type_name -> portcallv1.PortCallStatus
+	18, // 1: portcallv1.PortCall.eta:type_name -> google.protobuf.Timestamp
+	18, // 2: portcallv1.PortCall.etd:type_name -> google.protobuf.Timestamp
+	18, // 3: portcallv1.PortCall.ata:type_name -> google.protobuf.Timestamp
+	18, // 4: portcallv1.PortCall.atd:type_name -> google.protobuf.Timestamp
+	1,  // 5: portcallv1.ListResponse.port_calls:type_name -> portcallv1.PortCall
+	1,  // 6: portcallv1.GetResponse.port_call:type_name -> portcallv1.PortCall
+	18, // 7: portcallv1.CreateRequest.eta:type_name -> google.protobuf.Timestamp
+	18, // 8: portcallv1.CreateRequest.etd:type_name -> google.protobuf.Timestamp
+	18, // 9: portcallv1.UpdateRequest.eta:type_name -> google.protobuf.Timestamp
+	18, // 10: portcallv1.UpdateRequest.etd:type_name -> google.protobuf.Timestamp
+	18, // 11: portcallv1.ArriveRequest.ata:type_name -> google.protobuf.Timestamp
+	18, // 12: portcallv1.DepartRequest.atd:type_name -> google.protobuf.Timestamp
+	2,  // 13: portcallv1.PortCallService.List:input_type -> portcallv1.ListRequest
+	4,  // 14: portcallv1.PortCallService.Get:input_type -> portcallv1.GetRequest
+	6,  // 15: portcallv1.PortCallService.Create:input_type -> portcallv1.CreateRequest
+	8,  // 16: portcallv1.PortCallService.Update:input_type -> portcallv1.UpdateRequest
+	10, // 17: portcallv1.PortCallService.Delete:input_type -> portcallv1.DeleteRequest
+	12, // 18: portcallv1.PortCallService.Arrive:input_type -> portcallv1.ArriveRequest
+	14, // 19: portcallv1.PortCallService.Depart:input_type -> portcallv1.DepartRequest
+	16, // 20: portcallv1.PortCallService.Cancel:input_type -> portcallv1.CancelRequest
+	3,  // 21: portcallv1.PortCallService.List:output_type -> portcallv1.ListResponse
+	5,  // 22: portcallv1.PortCallService.Get:output_type -> portcallv1.GetResponse
+	7,  // 23: portcallv1.PortCallService.Create:output_type -> portcallv1.CreateResponse
+	9,  // 24: portcallv1.PortCallService.Update:output_type -> portcallv1.UpdateResponse
+	11, // 25: portcallv1.PortCallService.Delete:output_type -> portcallv1.DeleteResponse
+	13, // 26: portcallv1.PortCallService.Arrive:output_type -> portcallv1.ArriveResponse
+	15, // 27: portcallv1.PortCallService.Depart:output_type -> portcallv1.DepartResponse
+	17, // 28: portcallv1.PortCallService.Cancel:output_type -> portcallv1.CancelResponse
+	21, // [21:29] is the sub-list for method output_type
+	13, // [13:21] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_portcall_portcall_proto_init() }
+func file_portcall_portcall_proto_init() {
+	if File_portcall_portcall_proto != nil {
+		return
+	}
+	file_portcall_portcall_proto_msgTypes[0].OneofWrappers = []any{}
+	file_portcall_portcall_proto_msgTypes[1].OneofWrappers = []any{}
+	file_portcall_portcall_proto_msgTypes[2].OneofWrappers = []any{}
+	file_portcall_portcall_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_portcall_portcall_proto_rawDesc), len(file_portcall_portcall_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_portcall_portcall_proto_goTypes,
+		DependencyIndexes: file_portcall_portcall_proto_depIdxs,
+		EnumInfos:         file_portcall_portcall_proto_enumTypes,
+		MessageInfos:      file_portcall_portcall_proto_msgTypes,
+	}.Build()
+	File_portcall_portcall_proto = out.File
+	file_portcall_portcall_proto_goTypes = nil
+	file_portcall_portcall_proto_depIdxs = nil
+}
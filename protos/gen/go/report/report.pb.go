@@ -7,6 +7,7 @@
 package reportv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -337,7 +338,7 @@ var File_report_report_proto protoreflect.FileDescriptor
 
 const file_report_report_proto_rawDesc = "" +
 	"\n" +
-	"\x13report/report.proto\x12\breportv1\"\x1c\n" +
+	"\x13report/report.proto\x12\breportv1\x1a\x1cgoogle/api/annotations.proto\"\x1c\n" +
 	"\x1aUnloadedCargoReportRequest\"\xb8\x01\n" +
 	"\x0fCargoDetailItem\x12\x1d\n" +
 	"\n" +
@@ -360,10 +361,10 @@ const file_report_report_proto_rawDesc = "" +
 	"\x0ftotal_volume_m3\x18\x04 \x01(\x01R\rtotalVolumeM3\x12!\n" +
 	"\fprocess_cost\x18\x05 \x01(\x01R\vprocessCost\"@\n" +
 	"\x0fCargoTypeReport\x12-\n" +
-	"\x05items\x18\x01 \x03(\v2\x17.reportv1.CargoTypeItemR\x05items2\xd0\x01\n" +
-	"\rReportService\x12`\n" +
-	"\x1bGenerateUnloadedCargoReport\x12$.reportv1.UnloadedCargoReportRequest\x1a\x1b.reportv1.CargoDetailReport\x12]\n" +
-	"\x1eGenerateCargoTypeSummaryReport\x12 .reportv1.CargoTypeReportRequest\x1a\x19.reportv1.CargoTypeReportB9Z7github.com/deadsnxcks/dbcp/protos/proto/report;reportv1b\x06proto3"
+	"\x05items\x18\x01 \x03(\v2\x17.reportv1.CargoTypeItemR\x05items2\x9e\x02\n" +
+	"\rReportService\x12\x84\x01\n" +
+	"\x1bGenerateUnloadedCargoReport\x12$.reportv1.UnloadedCargoReportRequest\x1a\x1b.reportv1.CargoDetailReport\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/reports/unloaded-cargo\x12\x85\x01\n" +
+	"\x1eGenerateCargoTypeSummaryReport\x12 .reportv1.CargoTypeReportRequest\x1a\x19.reportv1.CargoTypeReport\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/reports/cargo-type-summaryB9Z7github.com/deadsnxcks/dbcp/protos/proto/report;reportv1b\x06proto3"
 
 var (
 	file_report_report_proto_rawDescOnce sync.Once
@@ -7,6 +7,7 @@
 package storagelocv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,16 +23,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlacementStrategy int32
+
+const (
+	PlacementStrategy_PLACEMENT_STRATEGY_UNSPECIFIED PlacementStrategy = 0
+	PlacementStrategy_PLACEMENT_STRATEGY_BEST_FIT    PlacementStrategy = 1
+	PlacementStrategy_PLACEMENT_STRATEGY_WORST_FIT   PlacementStrategy = 2
+	PlacementStrategy_PLACEMENT_STRATEGY_FIRST_FIT   PlacementStrategy = 3
+)
+
+// Enum value maps for PlacementStrategy.
+var (
+	PlacementStrategy_name = map[int32]string{
+		0: "PLACEMENT_STRATEGY_UNSPECIFIED",
+		1: "PLACEMENT_STRATEGY_BEST_FIT",
+		2: "PLACEMENT_STRATEGY_WORST_FIT",
+		3: "PLACEMENT_STRATEGY_FIRST_FIT",
+	}
+	PlacementStrategy_value = map[string]int32{
+		"PLACEMENT_STRATEGY_UNSPECIFIED": 0,
+		"PLACEMENT_STRATEGY_BEST_FIT":    1,
+		"PLACEMENT_STRATEGY_WORST_FIT":   2,
+		"PLACEMENT_STRATEGY_FIRST_FIT":   3,
+	}
+)
+
+func (x PlacementStrategy) Enum() *PlacementStrategy {
+	p := new(PlacementStrategy)
+	*p = x
+	return p
+}
+
+func (x PlacementStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlacementStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_storageloc_storageloc_proto_enumTypes[0].Descriptor()
+}
+
+func (PlacementStrategy) Type() protoreflect.EnumType {
+	return &file_storageloc_storageloc_proto_enumTypes[0]
+}
+
+func (x PlacementStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlacementStrategy.Descriptor instead.
+func (PlacementStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{0}
+}
+
+type UnplacedReason int32
+
+const (
+	UnplacedReason_UNPLACED_REASON_UNSPECIFIED       UnplacedReason = 0
+	UnplacedReason_UNPLACED_REASON_TYPE_NOT_SUITABLE UnplacedReason = 1
+	UnplacedReason_UNPLACED_REASON_NOT_SUITABLE      UnplacedReason = 2
+)
+
+// Enum value maps for UnplacedReason.
+var (
+	UnplacedReason_name = map[int32]string{
+		0: "UNPLACED_REASON_UNSPECIFIED",
+		1: "UNPLACED_REASON_TYPE_NOT_SUITABLE",
+		2: "UNPLACED_REASON_NOT_SUITABLE",
+	}
+	UnplacedReason_value = map[string]int32{
+		"UNPLACED_REASON_UNSPECIFIED":       0,
+		"UNPLACED_REASON_TYPE_NOT_SUITABLE": 1,
+		"UNPLACED_REASON_NOT_SUITABLE":      2,
+	}
+)
+
+func (x UnplacedReason) Enum() *UnplacedReason {
+	p := new(UnplacedReason)
+	*p = x
+	return p
+}
+
+func (x UnplacedReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnplacedReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_storageloc_storageloc_proto_enumTypes[1].Descriptor()
+}
+
+func (UnplacedReason) Type() protoreflect.EnumType {
+	return &file_storageloc_storageloc_proto_enumTypes[1]
+}
+
+func (x UnplacedReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnplacedReason.Descriptor instead.
+func (UnplacedReason) EnumDescriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{1}
+}
+
 type StorageLocation struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CargoTypeId     int64                  `protobuf:"varint,2,opt,name=cargo_type_id,json=cargoTypeId,proto3" json:"cargo_type_id,omitempty"`
-	MaxWeight       float64                `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
-	MaxVolume       float64                `protobuf:"fixed64,4,opt,name=max_volume,json=maxVolume,proto3" json:"max_volume,omitempty"`
-	CargoId         *int64                 `protobuf:"varint,5,opt,name=cargo_id,json=cargoId,proto3,oneof" json:"cargo_id,omitempty"`
-	DateOfPlacement *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_of_placement,json=dateOfPlacement,proto3,oneof" json:"date_of_placement,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CargoTypeId   int64                  `protobuf:"varint,2,opt,name=cargo_type_id,json=cargoTypeId,proto3" json:"cargo_type_id,omitempty"`
+	MaxWeight     float64                `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	MaxVolume     float64                `protobuf:"fixed64,4,opt,name=max_volume,json=maxVolume,proto3" json:"max_volume,omitempty"`
+	UsedWeight    float64                `protobuf:"fixed64,7,opt,name=used_weight,json=usedWeight,proto3" json:"used_weight,omitempty"`
+	UsedVolume    float64                `protobuf:"fixed64,8,opt,name=used_volume,json=usedVolume,proto3" json:"used_volume,omitempty"`
+	CargoCount    int64                  `protobuf:"varint,9,opt,name=cargo_count,json=cargoCount,proto3" json:"cargo_count,omitempty"`
+	Version       int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StorageLocation) Reset() {
@@ -92,29 +196,214 @@ func (x *StorageLocation) GetMaxVolume() float64 {
 	return 0
 }
 
-func (x *StorageLocation) GetCargoId() int64 {
-	if x != nil && x.CargoId != nil {
-		return *x.CargoId
+func (x *StorageLocation) GetUsedWeight() float64 {
+	if x != nil {
+		return x.UsedWeight
 	}
 	return 0
 }
 
-func (x *StorageLocation) GetDateOfPlacement() *timestamppb.Timestamp {
+func (x *StorageLocation) GetUsedVolume() float64 {
 	if x != nil {
-		return x.DateOfPlacement
+		return x.UsedVolume
 	}
-	return nil
+	return 0
 }
 
-type ListRequest struct {
+func (x *StorageLocation) GetCargoCount() int64 {
+	if x != nil {
+		return x.CargoCount
+	}
+	return 0
+}
+
+func (x *StorageLocation) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type StoredCargo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlacementId   int64                  `protobuf:"varint,1,opt,name=placement_id,json=placementId,proto3" json:"placement_id,omitempty"`
+	CargoId       int64                  `protobuf:"varint,2,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	TypeId        int64                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	PlacedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *StoredCargo) Reset() {
+	*x = StoredCargo{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredCargo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredCargo) ProtoMessage() {}
+
+func (x *StoredCargo) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredCargo.ProtoReflect.Descriptor instead.
+func (*StoredCargo) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoredCargo) GetPlacementId() int64 {
+	if x != nil {
+		return x.PlacementId
+	}
+	return 0
+}
+
+func (x *StoredCargo) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *StoredCargo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StoredCargo) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *StoredCargo) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *StoredCargo) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *StoredCargo) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+type StoragePlacement struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StorageLocationId int64                  `protobuf:"varint,2,opt,name=storage_location_id,json=storageLocationId,proto3" json:"storage_location_id,omitempty"`
+	CargoId           int64                  `protobuf:"varint,3,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	PlacedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	ReleasedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=released_at,json=releasedAt,proto3,oneof" json:"released_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StoragePlacement) Reset() {
+	*x = StoragePlacement{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePlacement) ProtoMessage() {}
+
+func (x *StoragePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePlacement.ProtoReflect.Descriptor instead.
+func (*StoragePlacement) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoragePlacement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StoragePlacement) GetStorageLocationId() int64 {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return 0
+}
+
+func (x *StoragePlacement) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *StoragePlacement) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+func (x *StoragePlacement) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+type ListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListRequest) Reset() {
 	*x = ListRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[1]
+	mi := &file_storageloc_storageloc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -126,7 +415,7 @@ func (x *ListRequest) String() string {
 func (*ListRequest) ProtoMessage() {}
 
 func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[1]
+	mi := &file_storageloc_storageloc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,19 +428,42 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{1}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
 }
 
 type ListResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	StorageLocations []*StorageLocation     `protobuf:"bytes,1,rep,name=storage_locations,json=storageLocations,proto3" json:"storage_locations,omitempty"`
+	NextPageToken    string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount       *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListResponse) Reset() {
 	*x = ListResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[2]
+	mi := &file_storageloc_storageloc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +475,7 @@ func (x *ListResponse) String() string {
 func (*ListResponse) ProtoMessage() {}
 
 func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[2]
+	mi := &file_storageloc_storageloc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +488,7 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{2}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListResponse) GetStorageLocations() []*StorageLocation {
@@ -186,6 +498,20 @@ func (x *ListResponse) GetStorageLocations() []*StorageLocation {
 	return nil
 }
 
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 type GetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -195,7 +521,7 @@ type GetRequest struct {
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[3]
+	mi := &file_storageloc_storageloc_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -207,7 +533,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[3]
+	mi := &file_storageloc_storageloc_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +546,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{3}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetRequest) GetId() int64 {
@@ -239,7 +565,7 @@ type GetResponse struct {
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[4]
+	mi := &file_storageloc_storageloc_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +577,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[4]
+	mi := &file_storageloc_storageloc_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +590,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{4}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetResponse) GetStorageLocation() *StorageLocation {
@@ -285,7 +611,7 @@ type CreateRequest struct {
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[5]
+	mi := &file_storageloc_storageloc_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +623,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[5]
+	mi := &file_storageloc_storageloc_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +636,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{5}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateRequest) GetCargoTypeId() int64 {
@@ -343,7 +669,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[6]
+	mi := &file_storageloc_storageloc_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +681,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[6]
+	mi := &file_storageloc_storageloc_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +694,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{6}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateResponse) GetId() int64 {
@@ -379,18 +705,19 @@ func (x *CreateResponse) GetId() int64 {
 }
 
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CargoTypeId   *int64                 `protobuf:"varint,2,opt,name=cargo_type_id,json=cargoTypeId,proto3,oneof" json:"cargo_type_id,omitempty"`
-	MaxWeight     *float64               `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3,oneof" json:"max_weight,omitempty"`
-	MaxVolume     *float64               `protobuf:"fixed64,4,opt,name=max_volume,json=maxVolume,proto3,oneof" json:"max_volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CargoTypeId     *int64                 `protobuf:"varint,2,opt,name=cargo_type_id,json=cargoTypeId,proto3,oneof" json:"cargo_type_id,omitempty"`
+	MaxWeight       *float64               `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3,oneof" json:"max_weight,omitempty"`
+	MaxVolume       *float64               `protobuf:"fixed64,4,opt,name=max_volume,json=maxVolume,proto3,oneof" json:"max_volume,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[7]
+	mi := &file_storageloc_storageloc_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +729,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[7]
+	mi := &file_storageloc_storageloc_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +742,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{7}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateRequest) GetId() int64 {
@@ -446,6 +773,13 @@ func (x *UpdateRequest) GetMaxVolume() float64 {
 	return 0
 }
 
+func (x *UpdateRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -454,7 +788,7 @@ type UpdateResponse struct {
 
 func (x *UpdateResponse) Reset() {
 	*x = UpdateResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[8]
+	mi := &file_storageloc_storageloc_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +800,7 @@ func (x *UpdateResponse) String() string {
 func (*UpdateResponse) ProtoMessage() {}
 
 func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[8]
+	mi := &file_storageloc_storageloc_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +813,7 @@ func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{8}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{10}
 }
 
 type DeleteRequest struct {
@@ -491,7 +825,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[9]
+	mi := &file_storageloc_storageloc_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +837,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[9]
+	mi := &file_storageloc_storageloc_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +850,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{9}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteRequest) GetId() int64 {
@@ -534,7 +868,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[10]
+	mi := &file_storageloc_storageloc_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +880,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[10]
+	mi := &file_storageloc_storageloc_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +893,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{10}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{12}
 }
 
 type UseRequest struct {
@@ -573,7 +907,7 @@ type UseRequest struct {
 
 func (x *UseRequest) Reset() {
 	*x = UseRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[11]
+	mi := &file_storageloc_storageloc_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -585,7 +919,7 @@ func (x *UseRequest) String() string {
 func (*UseRequest) ProtoMessage() {}
 
 func (x *UseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[11]
+	mi := &file_storageloc_storageloc_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +932,7 @@ func (x *UseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseRequest.ProtoReflect.Descriptor instead.
 func (*UseRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{11}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UseRequest) GetStorageLocationId() int64 {
@@ -630,7 +964,7 @@ type UseResponse struct {
 
 func (x *UseResponse) Reset() {
 	*x = UseResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[12]
+	mi := &file_storageloc_storageloc_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +976,7 @@ func (x *UseResponse) String() string {
 func (*UseResponse) ProtoMessage() {}
 
 func (x *UseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[12]
+	mi := &file_storageloc_storageloc_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,19 +989,20 @@ func (x *UseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseResponse.ProtoReflect.Descriptor instead.
 func (*UseResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{12}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{14}
 }
 
 type ResetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CargoId       *int64                 `protobuf:"varint,2,opt,name=cargo_id,json=cargoId,proto3,oneof" json:"cargo_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResetRequest) Reset() {
 	*x = ResetRequest{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[13]
+	mi := &file_storageloc_storageloc_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +1014,7 @@ func (x *ResetRequest) String() string {
 func (*ResetRequest) ProtoMessage() {}
 
 func (x *ResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[13]
+	mi := &file_storageloc_storageloc_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +1027,7 @@ func (x *ResetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetRequest.ProtoReflect.Descriptor instead.
 func (*ResetRequest) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{13}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ResetRequest) GetId() int64 {
@@ -702,6 +1037,13 @@ func (x *ResetRequest) GetId() int64 {
 	return 0
 }
 
+func (x *ResetRequest) GetCargoId() int64 {
+	if x != nil && x.CargoId != nil {
+		return *x.CargoId
+	}
+	return 0
+}
+
 type ResetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -710,7 +1052,7 @@ type ResetResponse struct {
 
 func (x *ResetResponse) Reset() {
 	*x = ResetResponse{}
-	mi := &file_storageloc_storageloc_proto_msgTypes[14]
+	mi := &file_storageloc_storageloc_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +1064,7 @@ func (x *ResetResponse) String() string {
 func (*ResetResponse) ProtoMessage() {}
 
 func (x *ResetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storageloc_storageloc_proto_msgTypes[14]
+	mi := &file_storageloc_storageloc_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,72 +1077,906 @@ func (x *ResetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetResponse.ProtoReflect.Descriptor instead.
 func (*ResetResponse) Descriptor() ([]byte, []int) {
-	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{14}
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{16}
 }
 
-var File_storageloc_storageloc_proto protoreflect.FileDescriptor
+type HistoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount bool                   `protobuf:"varint,4,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_storageloc_storageloc_proto_rawDesc = "" +
-	"\n" +
-	"\x1bstorageloc/storageloc.proto\x12\fstoragelocv1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x02\n" +
-	"\x0fStorageLocation\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
-	"\rcargo_type_id\x18\x02 \x01(\x03R\vcargoTypeId\x12\x1d\n" +
-	"\n" +
-	"max_weight\x18\x03 \x01(\x01R\tmaxWeight\x12\x1d\n" +
-	"\n" +
-	"max_volume\x18\x04 \x01(\x01R\tmaxVolume\x12\x1e\n" +
-	"\bcargo_id\x18\x05 \x01(\x03H\x00R\acargoId\x88\x01\x01\x12K\n" +
-	"\x11date_of_placement\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0fdateOfPlacement\x88\x01\x01B\v\n" +
-	"\t_cargo_idB\x14\n" +
-	"\x12_date_of_placement\"\r\n" +
-	"\vListRequest\"Z\n" +
-	"\fListResponse\x12J\n" +
-	"\x11storage_locations\x18\x01 \x03(\v2\x1d.storagelocv1.StorageLocationR\x10storageLocations\"\x1c\n" +
-	"\n" +
-	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"W\n" +
-	"\vGetResponse\x12H\n" +
-	"\x10storage_location\x18\x01 \x01(\v2\x1d.storagelocv1.StorageLocationR\x0fstorageLocation\"q\n" +
-	"\rCreateRequest\x12\"\n" +
-	"\rcargo_type_id\x18\x01 \x01(\x03R\vcargoTypeId\x12\x1d\n" +
-	"\n" +
-	"max_weight\x18\x02 \x01(\x01R\tmaxWeight\x12\x1d\n" +
-	"\n" +
-	"max_volume\x18\x03 \x01(\x01R\tmaxVolume\" \n" +
-	"\x0eCreateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc0\x01\n" +
-	"\rUpdateRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
-	"\rcargo_type_id\x18\x02 \x01(\x03H\x00R\vcargoTypeId\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"max_weight\x18\x03 \x01(\x01H\x01R\tmaxWeight\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"max_volume\x18\x04 \x01(\x01H\x02R\tmaxVolume\x88\x01\x01B\x10\n" +
-	"\x0e_cargo_type_idB\r\n" +
-	"\v_max_weightB\r\n" +
-	"\v_max_volume\"\x10\n" +
-	"\x0eUpdateResponse\"\x1f\n" +
-	"\rDeleteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
-	"\x0eDeleteResponse\"\x9f\x01\n" +
-	"\n" +
-	"UseRequest\x12.\n" +
-	"\x13storage_location_id\x18\x01 \x01(\x03R\x11storageLocationId\x12\x19\n" +
-	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\x12F\n" +
-	"\x11date_of_placement\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fdateOfPlacement\"\r\n" +
-	"\vUseResponse\"\x1e\n" +
-	"\fResetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x0f\n" +
-	"\rResetResponse2\xe0\x03\n" +
-	"\x16StorageLocationService\x12=\n" +
-	"\x04List\x12\x19.storagelocv1.ListRequest\x1a\x1a.storagelocv1.ListResponse\x12C\n" +
-	"\x06Create\x12\x1b.storagelocv1.CreateRequest\x1a\x1c.storagelocv1.CreateResponse\x12C\n" +
-	"\x06Delete\x12\x1b.storagelocv1.DeleteRequest\x1a\x1c.storagelocv1.DeleteResponse\x12:\n" +
-	"\x03Get\x12\x18.storagelocv1.GetRequest\x1a\x19.storagelocv1.GetResponse\x12C\n" +
-	"\x06Update\x12\x1b.storagelocv1.UpdateRequest\x1a\x1c.storagelocv1.UpdateResponse\x12:\n" +
-	"\x03Use\x12\x18.storagelocv1.UseRequest\x1a\x19.storagelocv1.UseResponse\x12@\n" +
-	"\x05Reset\x12\x1a.storagelocv1.ResetRequest\x1a\x1b.storagelocv1.ResetResponseBAZ?github.com/deadsnxcks/dbcp/protos/proto/storageloc;storagelocv1b\x06proto3"
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HistoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placements    []*StoragePlacement    `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HistoryResponse) GetPlacements() []*StoragePlacement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *HistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *HistoryResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type ContentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentsRequest) Reset() {
+	*x = ContentsRequest{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentsRequest) ProtoMessage() {}
+
+func (x *ContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentsRequest.ProtoReflect.Descriptor instead.
+func (*ContentsRequest) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ContentsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ContentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ContentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cargos        []*StoredCargo         `protobuf:"bytes,1,rep,name=cargos,proto3" json:"cargos,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentsResponse) Reset() {
+	*x = ContentsResponse{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentsResponse) ProtoMessage() {}
+
+func (x *ContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentsResponse.ProtoReflect.Descriptor instead.
+func (*ContentsResponse) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ContentsResponse) GetCargos() []*StoredCargo {
+	if x != nil {
+		return x.Cargos
+	}
+	return nil
+}
+
+func (x *ContentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SuggestPlacementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CargoId       int64                  `protobuf:"varint,1,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	Strategy      PlacementStrategy      `protobuf:"varint,2,opt,name=strategy,proto3,enum=storagelocv1.PlacementStrategy" json:"strategy,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestPlacementRequest) Reset() {
+	*x = SuggestPlacementRequest{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestPlacementRequest) ProtoMessage() {}
+
+func (x *SuggestPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestPlacementRequest.ProtoReflect.Descriptor instead.
+func (*SuggestPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SuggestPlacementRequest) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *SuggestPlacementRequest) GetStrategy() PlacementStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return PlacementStrategy_PLACEMENT_STRATEGY_UNSPECIFIED
+}
+
+func (x *SuggestPlacementRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PlacementCandidate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StorageLocation *StorageLocation       `protobuf:"bytes,1,opt,name=storage_location,json=storageLocation,proto3" json:"storage_location,omitempty"`
+	RemainingWeight float64                `protobuf:"fixed64,2,opt,name=remaining_weight,json=remainingWeight,proto3" json:"remaining_weight,omitempty"`
+	RemainingVolume float64                `protobuf:"fixed64,3,opt,name=remaining_volume,json=remainingVolume,proto3" json:"remaining_volume,omitempty"`
+	Score           float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlacementCandidate) Reset() {
+	*x = PlacementCandidate{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementCandidate) ProtoMessage() {}
+
+func (x *PlacementCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementCandidate.ProtoReflect.Descriptor instead.
+func (*PlacementCandidate) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PlacementCandidate) GetStorageLocation() *StorageLocation {
+	if x != nil {
+		return x.StorageLocation
+	}
+	return nil
+}
+
+func (x *PlacementCandidate) GetRemainingWeight() float64 {
+	if x != nil {
+		return x.RemainingWeight
+	}
+	return 0
+}
+
+func (x *PlacementCandidate) GetRemainingVolume() float64 {
+	if x != nil {
+		return x.RemainingVolume
+	}
+	return 0
+}
+
+func (x *PlacementCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SuggestPlacementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*PlacementCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestPlacementResponse) Reset() {
+	*x = SuggestPlacementResponse{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestPlacementResponse) ProtoMessage() {}
+
+func (x *SuggestPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestPlacementResponse.ProtoReflect.Descriptor instead.
+func (*SuggestPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SuggestPlacementResponse) GetCandidates() []*PlacementCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type AutoPlaceVesselRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VesselId        int64                  `protobuf:"varint,1,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	DateOfPlacement *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_of_placement,json=dateOfPlacement,proto3" json:"date_of_placement,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AutoPlaceVesselRequest) Reset() {
+	*x = AutoPlaceVesselRequest{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoPlaceVesselRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoPlaceVesselRequest) ProtoMessage() {}
+
+func (x *AutoPlaceVesselRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoPlaceVesselRequest.ProtoReflect.Descriptor instead.
+func (*AutoPlaceVesselRequest) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AutoPlaceVesselRequest) GetVesselId() int64 {
+	if x != nil {
+		return x.VesselId
+	}
+	return 0
+}
+
+func (x *AutoPlaceVesselRequest) GetDateOfPlacement() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfPlacement
+	}
+	return nil
+}
+
+type PlacedCargo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CargoId           int64                  `protobuf:"varint,1,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	StorageLocationId int64                  `protobuf:"varint,2,opt,name=storage_location_id,json=storageLocationId,proto3" json:"storage_location_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlacedCargo) Reset() {
+	*x = PlacedCargo{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacedCargo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacedCargo) ProtoMessage() {}
+
+func (x *PlacedCargo) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacedCargo.ProtoReflect.Descriptor instead.
+func (*PlacedCargo) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PlacedCargo) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *PlacedCargo) GetStorageLocationId() int64 {
+	if x != nil {
+		return x.StorageLocationId
+	}
+	return 0
+}
+
+type UnplacedCargo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CargoId       int64                  `protobuf:"varint,1,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	Reason        UnplacedReason         `protobuf:"varint,2,opt,name=reason,proto3,enum=storagelocv1.UnplacedReason" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnplacedCargo) Reset() {
+	*x = UnplacedCargo{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnplacedCargo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnplacedCargo) ProtoMessage() {}
+
+func (x *UnplacedCargo) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnplacedCargo.ProtoReflect.Descriptor instead.
+func (*UnplacedCargo) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnplacedCargo) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *UnplacedCargo) GetReason() UnplacedReason {
+	if x != nil {
+		return x.Reason
+	}
+	return UnplacedReason_UNPLACED_REASON_UNSPECIFIED
+}
+
+func (x *UnplacedCargo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AutoPlaceVesselResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placed        []*PlacedCargo         `protobuf:"bytes,1,rep,name=placed,proto3" json:"placed,omitempty"`
+	Unplaced      []*UnplacedCargo       `protobuf:"bytes,2,rep,name=unplaced,proto3" json:"unplaced,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoPlaceVesselResponse) Reset() {
+	*x = AutoPlaceVesselResponse{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoPlaceVesselResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoPlaceVesselResponse) ProtoMessage() {}
+
+func (x *AutoPlaceVesselResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoPlaceVesselResponse.ProtoReflect.Descriptor instead.
+func (*AutoPlaceVesselResponse) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AutoPlaceVesselResponse) GetPlaced() []*PlacedCargo {
+	if x != nil {
+		return x.Placed
+	}
+	return nil
+}
+
+func (x *AutoPlaceVesselResponse) GetUnplaced() []*UnplacedCargo {
+	if x != nil {
+		return x.Unplaced
+	}
+	return nil
+}
+
+type MoveRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CargoId             int64                  `protobuf:"varint,1,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	ToStorageLocationId int64                  `protobuf:"varint,2,opt,name=to_storage_location_id,json=toStorageLocationId,proto3" json:"to_storage_location_id,omitempty"`
+	DateOfMove          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_of_move,json=dateOfMove,proto3" json:"date_of_move,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MoveRequest) Reset() {
+	*x = MoveRequest{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRequest) ProtoMessage() {}
+
+func (x *MoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MoveRequest) GetCargoId() int64 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *MoveRequest) GetToStorageLocationId() int64 {
+	if x != nil {
+		return x.ToStorageLocationId
+	}
+	return 0
+}
+
+func (x *MoveRequest) GetDateOfMove() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfMove
+	}
+	return nil
+}
+
+type MoveResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	FromStorageLocationId int64                  `protobuf:"varint,1,opt,name=from_storage_location_id,json=fromStorageLocationId,proto3" json:"from_storage_location_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MoveResponse) Reset() {
+	*x = MoveResponse{}
+	mi := &file_storageloc_storageloc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveResponse) ProtoMessage() {}
+
+func (x *MoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storageloc_storageloc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveResponse.ProtoReflect.Descriptor instead.
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return file_storageloc_storageloc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MoveResponse) GetFromStorageLocationId() int64 {
+	if x != nil {
+		return x.FromStorageLocationId
+	}
+	return 0
+}
+
+var File_storageloc_storageloc_proto protoreflect.FileDescriptor
+
+const file_storageloc_storageloc_proto_rawDesc = "" +
+	"\n" +
+	"\x1bstorageloc/storageloc.proto\x12\fstoragelocv1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x02\n" +
+	"\x0fStorageLocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\rcargo_type_id\x18\x02 \x01(\x03R\vcargoTypeId\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x03 \x01(\x01R\tmaxWeight\x12\x1d\n" +
+	"\n" +
+	"max_volume\x18\x04 \x01(\x01R\tmaxVolume\x12\x1f\n" +
+	"\vused_weight\x18\a \x01(\x01R\n" +
+	"usedWeight\x12\x1f\n" +
+	"\vused_volume\x18\b \x01(\x01R\n" +
+	"usedVolume\x12\x1f\n" +
+	"\vcargo_count\x18\t \x01(\x03R\n" +
+	"cargoCount\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversionJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\bcargo_idR\x11date_of_placement\"\xe3\x01\n" +
+	"\vStoredCargo\x12!\n" +
+	"\fplacement_id\x18\x01 \x01(\x03R\vplacementId\x12\x19\n" +
+	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x03R\x06typeId\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x01R\x06volume\x127\n" +
+	"\tplaced_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\"\xf8\x01\n" +
+	"\x10StoragePlacement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12.\n" +
+	"\x13storage_location_id\x18\x02 \x01(\x03R\x11storageLocationId\x12\x19\n" +
+	"\bcargo_id\x18\x03 \x01(\x03R\acargoId\x127\n" +
+	"\tplaced_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\x12@\n" +
+	"\vreleased_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"releasedAt\x88\x01\x01B\x0e\n" +
+	"\f_released_at\"s\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\"\xb8\x01\n" +
+	"\fListResponse\x12J\n" +
+	"\x11storage_locations\x18\x01 \x03(\v2\x1d.storagelocv1.StorageLocationR\x10storageLocations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x1c\n" +
+	"\n" +
+	"GetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"W\n" +
+	"\vGetResponse\x12H\n" +
+	"\x10storage_location\x18\x01 \x01(\v2\x1d.storagelocv1.StorageLocationR\x0fstorageLocation\"q\n" +
+	"\rCreateRequest\x12\"\n" +
+	"\rcargo_type_id\x18\x01 \x01(\x03R\vcargoTypeId\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x02 \x01(\x01R\tmaxWeight\x12\x1d\n" +
+	"\n" +
+	"max_volume\x18\x03 \x01(\x01R\tmaxVolume\" \n" +
+	"\x0eCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x85\x02\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\rcargo_type_id\x18\x02 \x01(\x03H\x00R\vcargoTypeId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_weight\x18\x03 \x01(\x01H\x01R\tmaxWeight\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_volume\x18\x04 \x01(\x01H\x02R\tmaxVolume\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x05 \x01(\x03H\x03R\x0fexpectedVersion\x88\x01\x01B\x10\n" +
+	"\x0e_cargo_type_idB\r\n" +
+	"\v_max_weightB\r\n" +
+	"\v_max_volumeB\x13\n" +
+	"\x11_expected_version\"\x10\n" +
+	"\x0eUpdateResponse\"\x1f\n" +
+	"\rDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
+	"\x0eDeleteResponse\"\x9f\x01\n" +
+	"\n" +
+	"UseRequest\x12.\n" +
+	"\x13storage_location_id\x18\x01 \x01(\x03R\x11storageLocationId\x12\x19\n" +
+	"\bcargo_id\x18\x02 \x01(\x03R\acargoId\x12F\n" +
+	"\x11date_of_placement\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fdateOfPlacement\"\r\n" +
+	"\vUseResponse\"K\n" +
+	"\fResetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\bcargo_id\x18\x02 \x01(\x03H\x00R\acargoId\x88\x01\x01B\v\n" +
+	"\t_cargo_id\"\x0f\n" +
+	"\rResetResponse\"\x86\x01\n" +
+	"\x0eHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x04 \x01(\bR\x0ewithTotalCount\"\xaf\x01\n" +
+	"\x0fHistoryResponse\x12>\n" +
+	"\n" +
+	"placements\x18\x01 \x03(\v2\x1e.storagelocv1.StoragePlacementR\n" +
+	"placements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"]\n" +
+	"\x0fContentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"m\n" +
+	"\x10ContentsResponse\x121\n" +
+	"\x06cargos\x18\x01 \x03(\v2\x19.storagelocv1.StoredCargoR\x06cargos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x87\x01\n" +
+	"\x17SuggestPlacementRequest\x12\x19\n" +
+	"\bcargo_id\x18\x01 \x01(\x03R\acargoId\x12;\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x1f.storagelocv1.PlacementStrategyR\bstrategy\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xca\x01\n" +
+	"\x12PlacementCandidate\x12H\n" +
+	"\x10storage_location\x18\x01 \x01(\v2\x1d.storagelocv1.StorageLocationR\x0fstorageLocation\x12)\n" +
+	"\x10remaining_weight\x18\x02 \x01(\x01R\x0fremainingWeight\x12)\n" +
+	"\x10remaining_volume\x18\x03 \x01(\x01R\x0fremainingVolume\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\"\\\n" +
+	"\x18SuggestPlacementResponse\x12@\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2 .storagelocv1.PlacementCandidateR\n" +
+	"candidates\"}\n" +
+	"\x16AutoPlaceVesselRequest\x12\x1b\n" +
+	"\tvessel_id\x18\x01 \x01(\x03R\bvesselId\x12F\n" +
+	"\x11date_of_placement\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0fdateOfPlacement\"X\n" +
+	"\vPlacedCargo\x12\x19\n" +
+	"\bcargo_id\x18\x01 \x01(\x03R\acargoId\x12.\n" +
+	"\x13storage_location_id\x18\x02 \x01(\x03R\x11storageLocationId\"z\n" +
+	"\rUnplacedCargo\x12\x19\n" +
+	"\bcargo_id\x18\x01 \x01(\x03R\acargoId\x124\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1c.storagelocv1.UnplacedReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x85\x01\n" +
+	"\x17AutoPlaceVesselResponse\x121\n" +
+	"\x06placed\x18\x01 \x03(\v2\x19.storagelocv1.PlacedCargoR\x06placed\x127\n" +
+	"\bunplaced\x18\x02 \x03(\v2\x1b.storagelocv1.UnplacedCargoR\bunplaced\"\x9b\x01\n" +
+	"\vMoveRequest\x12\x19\n" +
+	"\bcargo_id\x18\x01 \x01(\x03R\acargoId\x123\n" +
+	"\x16to_storage_location_id\x18\x02 \x01(\x03R\x13toStorageLocationId\x12<\n" +
+	"\fdate_of_move\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"dateOfMove\"G\n" +
+	"\fMoveResponse\x127\n" +
+	"\x18from_storage_location_id\x18\x01 \x01(\x03R\x15fromStorageLocationId*\x9c\x01\n" +
+	"\x11PlacementStrategy\x12\"\n" +
+	"\x1ePLACEMENT_STRATEGY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bPLACEMENT_STRATEGY_BEST_FIT\x10\x01\x12 \n" +
+	"\x1cPLACEMENT_STRATEGY_WORST_FIT\x10\x02\x12 \n" +
+	"\x1cPLACEMENT_STRATEGY_FIRST_FIT\x10\x03*z\n" +
+	"\x0eUnplacedReason\x12\x1f\n" +
+	"\x1bUNPLACED_REASON_UNSPECIFIED\x10\x00\x12%\n" +
+	"!UNPLACED_REASON_TYPE_NOT_SUITABLE\x10\x01\x12 \n" +
+	"\x1cUNPLACED_REASON_NOT_SUITABLE\x10\x022\xf3\n" +
+	"\n" +
+	"\x16StorageLocationService\x12\\\n" +
+	"\x04List\x12\x19.storagelocv1.ListRequest\x1a\x1a.storagelocv1.ListResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/storage-locations\x12e\n" +
+	"\x06Create\x12\x1b.storagelocv1.CreateRequest\x1a\x1c.storagelocv1.CreateResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/storage-locations\x12g\n" +
+	"\x06Delete\x12\x1b.storagelocv1.DeleteRequest\x1a\x1c.storagelocv1.DeleteResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/storage-locations/{id}\x12^\n" +
+	"\x03Get\x12\x18.storagelocv1.GetRequest\x1a\x19.storagelocv1.GetResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/storage-locations/{id}\x12j\n" +
+	"\x06Update\x12\x1b.storagelocv1.UpdateRequest\x1a\x1c.storagelocv1.UpdateResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/v1/storage-locations/{id}\x12v\n" +
+	"\x03Use\x12\x18.storagelocv1.UseRequest\x1a\x19.storagelocv1.UseResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/storage-locations/{storage_location_id}:use\x12m\n" +
+	"\x05Reset\x12\x1a.storagelocv1.ResetRequest\x1a\x1b.storagelocv1.ResetResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/storage-locations/{id}:reset\x12r\n" +
+	"\aHistory\x12\x1c.storagelocv1.HistoryRequest\x1a\x1d.storagelocv1.HistoryResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/storage-locations/{id}/history\x12v\n" +
+	"\bContents\x12\x1d.storagelocv1.ContentsRequest\x1a\x1e.storagelocv1.ContentsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/storage-locations/{id}/contents\x12\x96\x01\n" +
+	"\x10SuggestPlacement\x12%.storagelocv1.SuggestPlacementRequest\x1a&.storagelocv1.SuggestPlacementResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/cargos/{cargo_id}/placement-suggestions\x12\x8c\x01\n" +
+	"\x0fAutoPlaceVessel\x12$.storagelocv1.AutoPlaceVesselRequest\x1a%.storagelocv1.AutoPlaceVesselResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/vessels/{vessel_id}:autoPlace\x12d\n" +
+	"\x04Move\x12\x19.storagelocv1.MoveRequest\x1a\x1a.storagelocv1.MoveResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cargos/{cargo_id}:moveBAZ?github.com/deadsnxcks/dbcp/protos/proto/storageloc;storagelocv1b\x06proto3"
 
 var (
 	file_storageloc_storageloc_proto_rawDescOnce sync.Once
@@ -814,49 +1990,89 @@ func file_storageloc_storageloc_proto_rawDescGZIP() []byte {
 	return file_storageloc_storageloc_proto_rawDescData
 }
 
-var file_storageloc_storageloc_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_storageloc_storageloc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_storageloc_storageloc_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_storageloc_storageloc_proto_goTypes = []any{
-	(*StorageLocation)(nil),       // 0: storagelocv1.StorageLocation
-	(*ListRequest)(nil),           // 1: storagelocv1.ListRequest
-	(*ListResponse)(nil),          // 2: storagelocv1.ListResponse
-	(*GetRequest)(nil),            // 3: storagelocv1.GetRequest
-	(*GetResponse)(nil),           // 4: storagelocv1.GetResponse
-	(*CreateRequest)(nil),         // 5: storagelocv1.CreateRequest
-	(*CreateResponse)(nil),        // 6: storagelocv1.CreateResponse
-	(*UpdateRequest)(nil),         // 7: storagelocv1.UpdateRequest
-	(*UpdateResponse)(nil),        // 8: storagelocv1.UpdateResponse
-	(*DeleteRequest)(nil),         // 9: storagelocv1.DeleteRequest
-	(*DeleteResponse)(nil),        // 10: storagelocv1.DeleteResponse
-	(*UseRequest)(nil),            // 11: storagelocv1.UseRequest
-	(*UseResponse)(nil),           // 12: storagelocv1.UseResponse
-	(*ResetRequest)(nil),          // 13: storagelocv1.ResetRequest
-	(*ResetResponse)(nil),         // 14: storagelocv1.ResetResponse
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(PlacementStrategy)(0),           // 0: storagelocv1.PlacementStrategy
+	(UnplacedReason)(0),              // 1: storagelocv1.UnplacedReason
+	(*StorageLocation)(nil),          // 2: storagelocv1.StorageLocation
+	(*StoredCargo)(nil),              // 3: storagelocv1.StoredCargo
+	(*StoragePlacement)(nil),         // 4: storagelocv1.StoragePlacement
+	(*ListRequest)(nil),              // 5: storagelocv1.ListRequest
+	(*ListResponse)(nil),             // 6: storagelocv1.ListResponse
+	(*GetRequest)(nil),               // 7: storagelocv1.GetRequest
+	(*GetResponse)(nil),              // 8: storagelocv1.GetResponse
+	(*CreateRequest)(nil),            // 9: storagelocv1.CreateRequest
+	(*CreateResponse)(nil),           // 10: storagelocv1.CreateResponse
+	(*UpdateRequest)(nil),            // 11: storagelocv1.UpdateRequest
+	(*UpdateResponse)(nil),           // 12: storagelocv1.UpdateResponse
+	(*DeleteRequest)(nil),            // 13: storagelocv1.DeleteRequest
+	(*DeleteResponse)(nil),           // 14: storagelocv1.DeleteResponse
+	(*UseRequest)(nil),               // 15: storagelocv1.UseRequest
+	(*UseResponse)(nil),              // 16: storagelocv1.UseResponse
+	(*ResetRequest)(nil),             // 17: storagelocv1.ResetRequest
+	(*ResetResponse)(nil),            // 18: storagelocv1.ResetResponse
+	(*HistoryRequest)(nil),           // 19: storagelocv1.HistoryRequest
+	(*HistoryResponse)(nil),          // 20: storagelocv1.HistoryResponse
+	(*ContentsRequest)(nil),          // 21: storagelocv1.ContentsRequest
+	(*ContentsResponse)(nil),         // 22: storagelocv1.ContentsResponse
+	(*SuggestPlacementRequest)(nil),  // 23: storagelocv1.SuggestPlacementRequest
+	(*PlacementCandidate)(nil),       // 24: storagelocv1.PlacementCandidate
+	(*SuggestPlacementResponse)(nil), // 25: storagelocv1.SuggestPlacementResponse
+	(*AutoPlaceVesselRequest)(nil),   // 26: storagelocv1.AutoPlaceVesselRequest
+	(*PlacedCargo)(nil),              // 27: storagelocv1.PlacedCargo
+	(*UnplacedCargo)(nil),            // 28: storagelocv1.UnplacedCargo
+	(*AutoPlaceVesselResponse)(nil),  // 29: storagelocv1.AutoPlaceVesselResponse
+	(*MoveRequest)(nil),              // 30: storagelocv1.MoveRequest
+	(*MoveResponse)(nil),             // 31: storagelocv1.MoveResponse
+	(*timestamppb.Timestamp)(nil),    // 32: google.protobuf.Timestamp
 }
 var file_storageloc_storageloc_proto_depIdxs = []int32{
-	15, // 0: storagelocv1.StorageLocation.date_of_placement:type_name -> google.protobuf.Timestamp
-	0,  // 1: storagelocv1.ListResponse.storage_locations:type_name -> storagelocv1.StorageLocation
-	0,  // 2: storagelocv1.GetResponse.storage_location:type_name -> storagelocv1.StorageLocation
-	15, // 3: storagelocv1.UseRequest.date_of_placement:type_name -> google.protobuf.Timestamp
-	1,  // 4: storagelocv1.StorageLocationService.List:input_type -> storagelocv1.ListRequest
-	5,  // 5: storagelocv1.StorageLocationService.Create:input_type -> storagelocv1.CreateRequest
-	9,  // 6: storagelocv1.StorageLocationService.Delete:input_type -> storagelocv1.DeleteRequest
-	3,  // 7: storagelocv1.StorageLocationService.Get:input_type -> storagelocv1.GetRequest
-	7,  // 8: storagelocv1.StorageLocationService.Update:input_type -> storagelocv1.UpdateRequest
-	11, // 9: storagelocv1.StorageLocationService.Use:input_type -> storagelocv1.UseRequest
-	13, // 10: storagelocv1.StorageLocationService.Reset:input_type -> storagelocv1.ResetRequest
-	2,  // 11: storagelocv1.StorageLocationService.List:output_type -> storagelocv1.ListResponse
-	6,  // 12: storagelocv1.StorageLocationService.Create:output_type -> storagelocv1.CreateResponse
-	10, // 13: storagelocv1.StorageLocationService.Delete:output_type -> storagelocv1.DeleteResponse
-	4,  // 14: storagelocv1.StorageLocationService.Get:output_type -> storagelocv1.GetResponse
-	8,  // 15: storagelocv1.StorageLocationService.Update:output_type -> storagelocv1.UpdateResponse
-	12, // 16: storagelocv1.StorageLocationService.Use:output_type -> storagelocv1.UseResponse
-	14, // 17: storagelocv1.StorageLocationService.Reset:output_type -> storagelocv1.ResetResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	32, // 0: storagelocv1.StoredCargo.placed_at:type_name -> google.protobuf.Timestamp
+	32, // 1: storagelocv1.StoragePlacement.placed_at:type_name -> google.protobuf.Timestamp
+	32, // 2: storagelocv1.StoragePlacement.released_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: storagelocv1.ListResponse.storage_locations:type_name -> storagelocv1.StorageLocation
+	2,  // 4: storagelocv1.GetResponse.storage_location:type_name -> storagelocv1.StorageLocation
+	32, // 5: storagelocv1.UseRequest.date_of_placement:type_name -> google.protobuf.Timestamp
+	4,  // 6: storagelocv1.HistoryResponse.placements:type_name -> storagelocv1.StoragePlacement
+	3,  // 7: storagelocv1.ContentsResponse.cargos:type_name -> storagelocv1.StoredCargo
+	0,  // 8: storagelocv1.SuggestPlacementRequest.strategy:type_name -> storagelocv1.PlacementStrategy
+	2,  // 9: storagelocv1.PlacementCandidate.storage_location:type_name -> storagelocv1.StorageLocation
+	24, // 10: storagelocv1.SuggestPlacementResponse.candidates:type_name -> storagelocv1.PlacementCandidate
+	32, // 11: storagelocv1.AutoPlaceVesselRequest.date_of_placement:type_name -> google.protobuf.Timestamp
+	1,  // 12: storagelocv1.UnplacedCargo.reason:type_name -> storagelocv1.UnplacedReason
+	27, // 13: storagelocv1.AutoPlaceVesselResponse.placed:type_name -> storagelocv1.PlacedCargo
+	28, // 14: storagelocv1.AutoPlaceVesselResponse.unplaced:type_name -> storagelocv1.UnplacedCargo
+	32, // 15: storagelocv1.MoveRequest.date_of_move:type_name -> google.protobuf.Timestamp
+	5,  // 16: storagelocv1.StorageLocationService.List:input_type -> storagelocv1.ListRequest
+	9,  // 17: storagelocv1.StorageLocationService.Create:input_type -> storagelocv1.CreateRequest
+	13, // 18: storagelocv1.StorageLocationService.Delete:input_type -> storagelocv1.DeleteRequest
+	7,  // 19: storagelocv1.StorageLocationService.Get:input_type -> storagelocv1.GetRequest
+	11, // 20: storagelocv1.StorageLocationService.Update:input_type -> storagelocv1.UpdateRequest
+	15, // 21: storagelocv1.StorageLocationService.Use:input_type -> storagelocv1.UseRequest
+	17, // 22: storagelocv1.StorageLocationService.Reset:input_type -> storagelocv1.ResetRequest
+	19, // 23: storagelocv1.StorageLocationService.History:input_type -> storagelocv1.HistoryRequest
+	21, // 24: storagelocv1.StorageLocationService.Contents:input_type -> storagelocv1.ContentsRequest
+	23, // 25: storagelocv1.StorageLocationService.SuggestPlacement:input_type -> storagelocv1.SuggestPlacementRequest
+	26, // 26: storagelocv1.StorageLocationService.AutoPlaceVessel:input_type -> storagelocv1.AutoPlaceVesselRequest
+	30, // 27: storagelocv1.StorageLocationService.Move:input_type -> storagelocv1.MoveRequest
+	6,  // 28: storagelocv1.StorageLocationService.List:output_type -> storagelocv1.ListResponse
+	10, // 29: storagelocv1.StorageLocationService.Create:output_type -> storagelocv1.CreateResponse
+	14, // 30: storagelocv1.StorageLocationService.Delete:output_type -> storagelocv1.DeleteResponse
+	8,  // 31: storagelocv1.StorageLocationService.Get:output_type -> storagelocv1.GetResponse
+	12, // 32: storagelocv1.StorageLocationService.Update:output_type -> storagelocv1.UpdateResponse
+	16, // 33: storagelocv1.StorageLocationService.Use:output_type -> storagelocv1.UseResponse
+	18, // 34: storagelocv1.StorageLocationService.Reset:output_type -> storagelocv1.ResetResponse
+	20, // 35: storagelocv1.StorageLocationService.History:output_type -> storagelocv1.HistoryResponse
+	22, // 36: storagelocv1.StorageLocationService.Contents:output_type -> storagelocv1.ContentsResponse
+	25, // 37: storagelocv1.StorageLocationService.SuggestPlacement:output_type -> storagelocv1.SuggestPlacementResponse
+	29, // 38: storagelocv1.StorageLocationService.AutoPlaceVessel:output_type -> storagelocv1.AutoPlaceVesselResponse
+	31, // 39: storagelocv1.StorageLocationService.Move:output_type -> storagelocv1.MoveResponse
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_storageloc_storageloc_proto_init() }
@@ -864,20 +2080,24 @@ func file_storageloc_storageloc_proto_init() {
 	if File_storageloc_storageloc_proto != nil {
 		return
 	}
-	file_storageloc_storageloc_proto_msgTypes[0].OneofWrappers = []any{}
-	file_storageloc_storageloc_proto_msgTypes[7].OneofWrappers = []any{}
+	file_storageloc_storageloc_proto_msgTypes[2].OneofWrappers = []any{}
+	file_storageloc_storageloc_proto_msgTypes[4].OneofWrappers = []any{}
+	file_storageloc_storageloc_proto_msgTypes[9].OneofWrappers = []any{}
+	file_storageloc_storageloc_proto_msgTypes[15].OneofWrappers = []any{}
+	file_storageloc_storageloc_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storageloc_storageloc_proto_rawDesc), len(file_storageloc_storageloc_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_storageloc_storageloc_proto_goTypes,
 		DependencyIndexes: file_storageloc_storageloc_proto_depIdxs,
+		EnumInfos:         file_storageloc_storageloc_proto_enumTypes,
 		MessageInfos:      file_storageloc_storageloc_proto_msgTypes,
 	}.Build()
 	File_storageloc_storageloc_proto = out.File
@@ -19,13 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StorageLocationService_List_FullMethodName   = "/storagelocv1.StorageLocationService/List"
-	StorageLocationService_Create_FullMethodName = "/storagelocv1.StorageLocationService/Create"
-	StorageLocationService_Delete_FullMethodName = "/storagelocv1.StorageLocationService/Delete"
-	StorageLocationService_Get_FullMethodName    = "/storagelocv1.StorageLocationService/Get"
-	StorageLocationService_Update_FullMethodName = "/storagelocv1.StorageLocationService/Update"
-	StorageLocationService_Use_FullMethodName    = "/storagelocv1.StorageLocationService/Use"
-	StorageLocationService_Reset_FullMethodName  = "/storagelocv1.StorageLocationService/Reset"
+	StorageLocationService_List_FullMethodName             = "/storagelocv1.StorageLocationService/List"
+	StorageLocationService_Create_FullMethodName           = "/storagelocv1.StorageLocationService/Create"
+	StorageLocationService_Delete_FullMethodName           = "/storagelocv1.StorageLocationService/Delete"
+	StorageLocationService_Get_FullMethodName              = "/storagelocv1.StorageLocationService/Get"
+	StorageLocationService_Update_FullMethodName           = "/storagelocv1.StorageLocationService/Update"
+	StorageLocationService_Use_FullMethodName              = "/storagelocv1.StorageLocationService/Use"
+	StorageLocationService_Reset_FullMethodName            = "/storagelocv1.StorageLocationService/Reset"
+	StorageLocationService_History_FullMethodName          = "/storagelocv1.StorageLocationService/History"
+	StorageLocationService_Contents_FullMethodName         = "/storagelocv1.StorageLocationService/Contents"
+	StorageLocationService_SuggestPlacement_FullMethodName = "/storagelocv1.StorageLocationService/SuggestPlacement"
+	StorageLocationService_AutoPlaceVessel_FullMethodName  = "/storagelocv1.StorageLocationService/AutoPlaceVessel"
+	StorageLocationService_Move_FullMethodName             = "/storagelocv1.StorageLocationService/Move"
 )
 
 // StorageLocationServiceClient is the client API for StorageLocationService service.
@@ -39,6 +44,11 @@ type StorageLocationServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Use(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*UseResponse, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Contents(ctx context.Context, in *ContentsRequest, opts ...grpc.CallOption) (*ContentsResponse, error)
+	SuggestPlacement(ctx context.Context, in *SuggestPlacementRequest, opts ...grpc.CallOption) (*SuggestPlacementResponse, error)
+	AutoPlaceVessel(ctx context.Context, in *AutoPlaceVesselRequest, opts ...grpc.CallOption) (*AutoPlaceVesselResponse, error)
+	Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
 }
 
 type storageLocationServiceClient struct {
@@ -119,6 +129,56 @@ func (c *storageLocationServiceClient) Reset(ctx context.Context, in *ResetReque
 	return out, nil
 }
 
+func (c *storageLocationServiceClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, StorageLocationService_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageLocationServiceClient) Contents(ctx context.Context, in *ContentsRequest, opts ...grpc.CallOption) (*ContentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentsResponse)
+	err := c.cc.Invoke(ctx, StorageLocationService_Contents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageLocationServiceClient) SuggestPlacement(ctx context.Context, in *SuggestPlacementRequest, opts ...grpc.CallOption) (*SuggestPlacementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestPlacementResponse)
+	err := c.cc.Invoke(ctx, StorageLocationService_SuggestPlacement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageLocationServiceClient) AutoPlaceVessel(ctx context.Context, in *AutoPlaceVesselRequest, opts ...grpc.CallOption) (*AutoPlaceVesselResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoPlaceVesselResponse)
+	err := c.cc.Invoke(ctx, StorageLocationService_AutoPlaceVessel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageLocationServiceClient) Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveResponse)
+	err := c.cc.Invoke(ctx, StorageLocationService_Move_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageLocationServiceServer is the server API for StorageLocationService service.
 // All implementations must embed UnimplementedStorageLocationServiceServer
 // for forward compatibility.
@@ -130,6 +190,11 @@ type StorageLocationServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Use(context.Context, *UseRequest) (*UseResponse, error)
 	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Contents(context.Context, *ContentsRequest) (*ContentsResponse, error)
+	SuggestPlacement(context.Context, *SuggestPlacementRequest) (*SuggestPlacementResponse, error)
+	AutoPlaceVessel(context.Context, *AutoPlaceVesselRequest) (*AutoPlaceVesselResponse, error)
+	Move(context.Context, *MoveRequest) (*MoveResponse, error)
 	mustEmbedUnimplementedStorageLocationServiceServer()
 }
 
@@ -161,6 +226,21 @@ func (UnimplementedStorageLocationServiceServer) Use(context.Context, *UseReques
 func (UnimplementedStorageLocationServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Reset not implemented")
 }
+func (UnimplementedStorageLocationServiceServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedStorageLocationServiceServer) Contents(context.Context, *ContentsRequest) (*ContentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Contents not implemented")
+}
+func (UnimplementedStorageLocationServiceServer) SuggestPlacement(context.Context, *SuggestPlacementRequest) (*SuggestPlacementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestPlacement not implemented")
+}
+func (UnimplementedStorageLocationServiceServer) AutoPlaceVessel(context.Context, *AutoPlaceVesselRequest) (*AutoPlaceVesselResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutoPlaceVessel not implemented")
+}
+func (UnimplementedStorageLocationServiceServer) Move(context.Context, *MoveRequest) (*MoveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Move not implemented")
+}
 func (UnimplementedStorageLocationServiceServer) mustEmbedUnimplementedStorageLocationServiceServer() {
 }
 func (UnimplementedStorageLocationServiceServer) testEmbeddedByValue() {}
@@ -309,6 +389,96 @@ func _StorageLocationService_Reset_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageLocationService_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageLocationServiceServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageLocationService_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageLocationServiceServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageLocationService_Contents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageLocationServiceServer).Contents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageLocationService_Contents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageLocationServiceServer).Contents(ctx, req.(*ContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageLocationService_SuggestPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageLocationServiceServer).SuggestPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageLocationService_SuggestPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageLocationServiceServer).SuggestPlacement(ctx, req.(*SuggestPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageLocationService_AutoPlaceVessel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoPlaceVesselRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageLocationServiceServer).AutoPlaceVessel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageLocationService_AutoPlaceVessel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageLocationServiceServer).AutoPlaceVessel(ctx, req.(*AutoPlaceVesselRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageLocationService_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageLocationServiceServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageLocationService_Move_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageLocationServiceServer).Move(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageLocationService_ServiceDesc is the grpc.ServiceDesc for StorageLocationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,6 +514,26 @@ var StorageLocationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _StorageLocationService_Reset_Handler,
 		},
+		{
+			MethodName: "History",
+			Handler:    _StorageLocationService_History_Handler,
+		},
+		{
+			MethodName: "Contents",
+			Handler:    _StorageLocationService_Contents_Handler,
+		},
+		{
+			MethodName: "SuggestPlacement",
+			Handler:    _StorageLocationService_SuggestPlacement_Handler,
+		},
+		{
+			MethodName: "AutoPlaceVessel",
+			Handler:    _StorageLocationService_AutoPlaceVessel_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _StorageLocationService_Move_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storageloc/storageloc.proto",
@@ -7,8 +7,10 @@
 package vesselv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,6 +29,8 @@ type Vessel struct {
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	VesselType    string                 `protobuf:"bytes,3,opt,name=vessel_type,json=vesselType,proto3" json:"vessel_type,omitempty"`
 	MaxLoad       float64                `protobuf:"fixed64,4,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,10 +93,28 @@ func (x *Vessel) GetMaxLoad() float64 {
 	return 0
 }
 
+func (x *Vessel) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vessel) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	WithTotalCount  bool                   `protobuf:"varint,3,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
@@ -125,9 +147,39 @@ func (*ListRequest) Descriptor() ([]byte, []int) {
 	return file_vessel_vessel_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type ListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Vessels       []*Vessel              `protobuf:"bytes,1,rep,name=vessels,proto3" json:"vessels,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,11 +221,26 @@ func (x *ListResponse) GetVessels() []*Vessel {
 	return nil
 }
 
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetRequest) Reset() {
@@ -213,6 +280,13 @@ func (x *GetRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type GetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Vessel        *Vessel                `protobuf:"bytes,1,opt,name=vessel,proto3" json:"vessel,omitempty"`
@@ -362,13 +436,14 @@ func (x *CreateResponse) GetId() int64 {
 }
 
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	VesselType    *string                `protobuf:"bytes,3,opt,name=vessel_type,json=vesselType,proto3,oneof" json:"vessel_type,omitempty"`
-	MaxLoad       *float64               `protobuf:"fixed64,4,opt,name=max_load,json=maxLoad,proto3,oneof" json:"max_load,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	VesselType      *string                `protobuf:"bytes,3,opt,name=vessel_type,json=vesselType,proto3,oneof" json:"vessel_type,omitempty"`
+	MaxLoad         *float64               `protobuf:"fixed64,4,opt,name=max_load,json=maxLoad,proto3,oneof" json:"max_load,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
@@ -429,6 +504,13 @@ func (x *UpdateRequest) GetMaxLoad() float64 {
 	return 0
 }
 
+func (x *UpdateRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -545,23 +627,237 @@ func (*DeleteResponse) Descriptor() ([]byte, []int) {
 	return file_vessel_vessel_proto_rawDescGZIP(), []int{10}
 }
 
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_vessel_vessel_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vessel_vessel_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_vessel_vessel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	mi := &file_vessel_vessel_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vessel_vessel_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_vessel_vessel_proto_rawDescGZIP(), []int{12}
+}
+
+type GetLoadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoadRequest) Reset() {
+	*x = GetLoadRequest{}
+	mi := &file_vessel_vessel_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoadRequest) ProtoMessage() {}
+
+func (x *GetLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vessel_vessel_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoadRequest.ProtoReflect.Descriptor instead.
+func (*GetLoadRequest) Descriptor() ([]byte, []int) {
+	return file_vessel_vessel_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetLoadRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetLoadResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	VesselId           int64                  `protobuf:"varint,1,opt,name=vessel_id,json=vesselId,proto3" json:"vessel_id,omitempty"`
+	MaxLoad            float64                `protobuf:"fixed64,2,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	CurrentLoad        float64                `protobuf:"fixed64,3,opt,name=current_load,json=currentLoad,proto3" json:"current_load,omitempty"`
+	RemainingCapacity  float64                `protobuf:"fixed64,4,opt,name=remaining_capacity,json=remainingCapacity,proto3" json:"remaining_capacity,omitempty"`
+	UtilisationPercent float64                `protobuf:"fixed64,5,opt,name=utilisation_percent,json=utilisationPercent,proto3" json:"utilisation_percent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetLoadResponse) Reset() {
+	*x = GetLoadResponse{}
+	mi := &file_vessel_vessel_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoadResponse) ProtoMessage() {}
+
+func (x *GetLoadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vessel_vessel_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoadResponse.ProtoReflect.Descriptor instead.
+func (*GetLoadResponse) Descriptor() ([]byte, []int) {
+	return file_vessel_vessel_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetLoadResponse) GetVesselId() int64 {
+	if x != nil {
+		return x.VesselId
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetMaxLoad() float64 {
+	if x != nil {
+		return x.MaxLoad
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetCurrentLoad() float64 {
+	if x != nil {
+		return x.CurrentLoad
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetRemainingCapacity() float64 {
+	if x != nil {
+		return x.RemainingCapacity
+	}
+	return 0
+}
+
+func (x *GetLoadResponse) GetUtilisationPercent() float64 {
+	if x != nil {
+		return x.UtilisationPercent
+	}
+	return 0
+}
+
 var File_vessel_vessel_proto protoreflect.FileDescriptor
 
 const file_vessel_vessel_proto_rawDesc = "" +
 	"\n" +
-	"\x13vessel/vessel.proto\x12\tvessel.v1\"j\n" +
+	"\x13vessel/vessel.proto\x12\tvessel.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x01\n" +
 	"\x06Vessel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
 	"\vvessel_type\x18\x03 \x01(\tR\n" +
 	"vesselType\x12\x19\n" +
-	"\bmax_load\x18\x04 \x01(\x01R\amaxLoad\"\r\n" +
-	"\vListRequest\";\n" +
+	"\bmax_load\x18\x04 \x01(\x01R\amaxLoad\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\x12@\n" +
+	"\varchived_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"archivedAt\x88\x01\x01B\x0e\n" +
+	"\f_archived_at\"\x9e\x01\n" +
+	"\vListRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10with_total_count\x18\x03 \x01(\bR\x0ewithTotalCount\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"\x99\x01\n" +
 	"\fListResponse\x12+\n" +
-	"\avessels\x18\x01 \x03(\v2\x11.vessel.v1.VesselR\avessels\"\x1c\n" +
+	"\avessels\x18\x01 \x03(\v2\x11.vessel.v1.VesselR\avessels\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"G\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"8\n" +
 	"\vGetResponse\x12)\n" +
 	"\x06vessel\x18\x01 \x01(\v2\x11.vessel.v1.VesselR\x06vessel\"a\n" +
 	"\rCreateRequest\x12\x14\n" +
@@ -570,26 +866,41 @@ const file_vessel_vessel_proto_rawDesc = "" +
 	"vesselType\x12\x19\n" +
 	"\bmax_load\x18\x03 \x01(\x01R\amaxLoad\" \n" +
 	"\x0eCreateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xa7\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xec\x01\n" +
 	"\rUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12$\n" +
 	"\vvessel_type\x18\x03 \x01(\tH\x01R\n" +
 	"vesselType\x88\x01\x01\x12\x1e\n" +
-	"\bmax_load\x18\x04 \x01(\x01H\x02R\amaxLoad\x88\x01\x01B\b\n" +
+	"\bmax_load\x18\x04 \x01(\x01H\x02R\amaxLoad\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x05 \x01(\x03H\x03R\x0fexpectedVersion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_vessel_typeB\v\n" +
-	"\t_max_load\"\x10\n" +
+	"\t_max_loadB\x13\n" +
+	"\x11_expected_version\"\x10\n" +
 	"\x0eUpdateResponse\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x10\n" +
-	"\x0eDeleteResponse2\xbb\x02\n" +
-	"\rVesselService\x127\n" +
-	"\x04List\x12\x16.vessel.v1.ListRequest\x1a\x17.vessel.v1.ListResponse\x124\n" +
-	"\x03Get\x12\x15.vessel.v1.GetRequest\x1a\x16.vessel.v1.GetResponse\x12=\n" +
-	"\x06Update\x12\x18.vessel.v1.UpdateRequest\x1a\x19.vessel.v1.UpdateResponse\x12=\n" +
-	"\x06Create\x12\x18.vessel.v1.CreateRequest\x1a\x19.vessel.v1.CreateResponse\x12=\n" +
-	"\x06Delete\x12\x18.vessel.v1.DeleteRequest\x1a\x19.vessel.v1.DeleteResponseB9Z7github.com/deadsnxcks/dbcp/protos/proto/vessel;vesselv1b\x06proto3"
+	"\x0eDeleteResponse\" \n" +
+	"\x0eRestoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x11\n" +
+	"\x0fRestoreResponse\" \n" +
+	"\x0eGetLoadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xcc\x01\n" +
+	"\x0fGetLoadResponse\x12\x1b\n" +
+	"\tvessel_id\x18\x01 \x01(\x03R\bvesselId\x12\x19\n" +
+	"\bmax_load\x18\x02 \x01(\x01R\amaxLoad\x12!\n" +
+	"\fcurrent_load\x18\x03 \x01(\x01R\vcurrentLoad\x12-\n" +
+	"\x12remaining_capacity\x18\x04 \x01(\x01R\x11remainingCapacity\x12/\n" +
+	"\x13utilisation_percent\x18\x05 \x01(\x01R\x12utilisationPercent2\x81\x05\n" +
+	"\rVesselService\x12L\n" +
+	"\x04List\x12\x16.vessel.v1.ListRequest\x1a\x17.vessel.v1.ListResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/vessels\x12N\n" +
+	"\x03Get\x12\x15.vessel.v1.GetRequest\x1a\x16.vessel.v1.GetResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/vessels/{id}\x12Z\n" +
+	"\x06Update\x12\x18.vessel.v1.UpdateRequest\x1a\x19.vessel.v1.UpdateResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/vessels/{id}\x12U\n" +
+	"\x06Create\x12\x18.vessel.v1.CreateRequest\x1a\x19.vessel.v1.CreateResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/vessels\x12W\n" +
+	"\x06Delete\x12\x18.vessel.v1.DeleteRequest\x1a\x19.vessel.v1.DeleteResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/vessels/{id}\x12_\n" +
+	"\aGetLoad\x12\x19.vessel.v1.GetLoadRequest\x1a\x1a.vessel.v1.GetLoadResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/vessels/{id}/load\x12e\n" +
+	"\aRestore\x12\x19.vessel.v1.RestoreRequest\x1a\x1a.vessel.v1.RestoreResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/vessels/{id}:restoreB9Z7github.com/deadsnxcks/dbcp/protos/proto/vessel;vesselv1b\x06proto3"
 
 var (
 	file_vessel_vessel_proto_rawDescOnce sync.Once
@@ -603,38 +914,48 @@ func file_vessel_vessel_proto_rawDescGZIP() []byte {
 	return file_vessel_vessel_proto_rawDescData
 }
 
-var file_vessel_vessel_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_vessel_vessel_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_vessel_vessel_proto_goTypes = []any{
-	(*Vessel)(nil),         // 0: vessel.v1.Vessel
-	(*ListRequest)(nil),    // 1: vessel.v1.ListRequest
-	(*ListResponse)(nil),   // 2: vessel.v1.ListResponse
-	(*GetRequest)(nil),     // 3: vessel.v1.GetRequest
-	(*GetResponse)(nil),    // 4: vessel.v1.GetResponse
-	(*CreateRequest)(nil),  // 5: vessel.v1.CreateRequest
-	(*CreateResponse)(nil), // 6: vessel.v1.CreateResponse
-	(*UpdateRequest)(nil),  // 7: vessel.v1.UpdateRequest
-	(*UpdateResponse)(nil), // 8: vessel.v1.UpdateResponse
-	(*DeleteRequest)(nil),  // 9: vessel.v1.DeleteRequest
-	(*DeleteResponse)(nil), // 10: vessel.v1.DeleteResponse
+	(*Vessel)(nil),                // 0: vessel.v1.Vessel
+	(*ListRequest)(nil),           // 1: vessel.v1.ListRequest
+	(*ListResponse)(nil),          // 2: vessel.v1.ListResponse
+	(*GetRequest)(nil),            // 3: vessel.v1.GetRequest
+	(*GetResponse)(nil),           // 4: vessel.v1.GetResponse
+	(*CreateRequest)(nil),         // 5: vessel.v1.CreateRequest
+	(*CreateResponse)(nil),        // 6: vessel.v1.CreateResponse
+	(*UpdateRequest)(nil),         // 7: vessel.v1.UpdateRequest
+	(*UpdateResponse)(nil),        // 8: vessel.v1.UpdateResponse
+	(*DeleteRequest)(nil),         // 9: vessel.v1.DeleteRequest
+	(*DeleteResponse)(nil),        // 10: vessel.v1.DeleteResponse
+	(*RestoreRequest)(nil),        // 11: vessel.v1.RestoreRequest
+	(*RestoreResponse)(nil),       // 12: vessel.v1.RestoreResponse
+	(*GetLoadRequest)(nil),        // 13: vessel.v1.GetLoadRequest
+	(*GetLoadResponse)(nil),       // 14: vessel.v1.GetLoadResponse
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
 }
 var file_vessel_vessel_proto_depIdxs = []int32{
-	0,  // 0: vessel.v1.ListResponse.vessels:type_name -> vessel.v1.Vessel
-	0,  // 1: vessel.v1.GetResponse.vessel:type_name -> vessel.v1.Vessel
-	1,  // 2: vessel.v1.VesselService.List:input_type -> vessel.v1.ListRequest
-	3,  // 3: vessel.v1.VesselService.Get:input_type -> vessel.v1.GetRequest
-	7,  // 4: vessel.v1.VesselService.Update:input_type -> vessel.v1.UpdateRequest
-	5,  // 5: vessel.v1.VesselService.Create:input_type -> vessel.v1.CreateRequest
-	9,  // 6: vessel.v1.VesselService.Delete:input_type -> vessel.v1.DeleteRequest
-	2,  // 7: vessel.v1.VesselService.List:output_type -> vessel.v1.ListResponse
-	4,  // 8: vessel.v1.VesselService.Get:output_type -> vessel.v1.GetResponse
-	8,  // 9: vessel.v1.VesselService.Update:output_type -> vessel.v1.UpdateResponse
-	6,  // 10: vessel.v1.VesselService.Create:output_type -> vessel.v1.CreateResponse
-	10, // 11: vessel.v1.VesselService.Delete:output_type -> vessel.v1.DeleteResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	15, // 0: vessel.v1.Vessel.archived_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: vessel.v1.ListResponse.vessels:type_name -> vessel.v1.Vessel
+	0,  // 2: vessel.v1.GetResponse.vessel:type_name -> vessel.v1.Vessel
+	1,  // 3: vessel.v1.VesselService.List:input_type -> vessel.v1.ListRequest
+	3,  // 4: vessel.v1.VesselService.Get:input_type -> vessel.v1.GetRequest
+	7,  // 5: vessel.v1.VesselService.Update:input_type -> vessel.v1.UpdateRequest
+	5,  // 6: vessel.v1.VesselService.Create:input_type -> vessel.v1.CreateRequest
+	9,  // 7: vessel.v1.VesselService.Delete:input_type -> vessel.v1.DeleteRequest
+	13, // 8: vessel.v1.VesselService.GetLoad:input_type -> vessel.v1.GetLoadRequest
+	11, // 9: vessel.v1.VesselService.Restore:input_type -> vessel.v1.RestoreRequest
+	2,  // 10: vessel.v1.VesselService.List:output_type -> vessel.v1.ListResponse
+	4,  // 11: vessel.v1.VesselService.Get:output_type -> vessel.v1.GetResponse
+	8,  // 12: vessel.v1.VesselService.Update:output_type -> vessel.v1.UpdateResponse
+	6,  // 13: vessel.v1.VesselService.Create:output_type -> vessel.v1.CreateResponse
+	10, // 14: vessel.v1.VesselService.Delete:output_type -> vessel.v1.DeleteResponse
+	14, // 15: vessel.v1.VesselService.GetLoad:output_type -> vessel.v1.GetLoadResponse
+	12, // 16: vessel.v1.VesselService.Restore:output_type -> vessel.v1.RestoreResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_vessel_vessel_proto_init() }
@@ -642,6 +963,8 @@ func file_vessel_vessel_proto_init() {
 	if File_vessel_vessel_proto != nil {
 		return
 	}
+	file_vessel_vessel_proto_msgTypes[0].OneofWrappers = []any{}
+	file_vessel_vessel_proto_msgTypes[2].OneofWrappers = []any{}
 	file_vessel_vessel_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -649,7 +972,7 @@ func file_vessel_vessel_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vessel_vessel_proto_rawDesc), len(file_vessel_vessel_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
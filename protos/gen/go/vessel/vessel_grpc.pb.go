@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VesselService_List_FullMethodName   = "/vessel.v1.VesselService/List"
-	VesselService_Get_FullMethodName    = "/vessel.v1.VesselService/Get"
-	VesselService_Update_FullMethodName = "/vessel.v1.VesselService/Update"
-	VesselService_Create_FullMethodName = "/vessel.v1.VesselService/Create"
-	VesselService_Delete_FullMethodName = "/vessel.v1.VesselService/Delete"
+	VesselService_List_FullMethodName    = "/vessel.v1.VesselService/List"
+	VesselService_Get_FullMethodName     = "/vessel.v1.VesselService/Get"
+	VesselService_Update_FullMethodName  = "/vessel.v1.VesselService/Update"
+	VesselService_Create_FullMethodName  = "/vessel.v1.VesselService/Create"
+	VesselService_Delete_FullMethodName  = "/vessel.v1.VesselService/Delete"
+	VesselService_GetLoad_FullMethodName = "/vessel.v1.VesselService/GetLoad"
+	VesselService_Restore_FullMethodName = "/vessel.v1.VesselService/Restore"
 )
 
 // VesselServiceClient is the client API for VesselService service.
@@ -35,6 +37,8 @@ type VesselServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	GetLoad(ctx context.Context, in *GetLoadRequest, opts ...grpc.CallOption) (*GetLoadResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
 }
 
 type vesselServiceClient struct {
@@ -95,6 +99,26 @@ func (c *vesselServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *vesselServiceClient) GetLoad(ctx context.Context, in *GetLoadRequest, opts ...grpc.CallOption) (*GetLoadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoadResponse)
+	err := c.cc.Invoke(ctx, VesselService_GetLoad_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vesselServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, VesselService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VesselServiceServer is the server API for VesselService service.
 // All implementations must embed UnimplementedVesselServiceServer
 // for forward compatibility.
@@ -104,6 +128,8 @@ type VesselServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	GetLoad(context.Context, *GetLoadRequest) (*GetLoadResponse, error)
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
 	mustEmbedUnimplementedVesselServiceServer()
 }
 
@@ -129,6 +155,12 @@ func (UnimplementedVesselServiceServer) Create(context.Context, *CreateRequest)
 func (UnimplementedVesselServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedVesselServiceServer) GetLoad(context.Context, *GetLoadRequest) (*GetLoadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLoad not implemented")
+}
+func (UnimplementedVesselServiceServer) Restore(context.Context, *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedVesselServiceServer) mustEmbedUnimplementedVesselServiceServer() {}
 func (UnimplementedVesselServiceServer) testEmbeddedByValue()                       {}
 
@@ -240,6 +272,42 @@ func _VesselService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VesselService_GetLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VesselServiceServer).GetLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VesselService_GetLoad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VesselServiceServer).GetLoad(ctx, req.(*GetLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VesselService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VesselServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VesselService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VesselServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VesselService_ServiceDesc is the grpc.ServiceDesc for VesselService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +335,14 @@ var VesselService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _VesselService_Delete_Handler,
 		},
+		{
+			MethodName: "GetLoad",
+			Handler:    _VesselService_GetLoad_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _VesselService_Restore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vessel/vessel.proto",